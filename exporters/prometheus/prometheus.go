@@ -190,6 +190,10 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 				if err := c.exportHistogram(ch, v, numberKind, desc, attrs); err != nil {
 					return fmt.Errorf("exporting histogram: %w", err)
 				}
+			case aggregation.ExponentialHistogram:
+				// Exponential histograms also implement Sum, but
+				// have no Prometheus representation.
+				return fmt.Errorf("%w: %s", ErrUnsupportedAggregator, agg.Kind())
 			case aggregation.Sum:
 				if instrumentKind.Monotonic() {
 					if err := c.exportMonotonicCounter(ch, v, numberKind, desc, attrs); err != nil {
@@ -20,10 +20,12 @@ import (
 	"net/http/httptest"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric/instrument"
@@ -179,6 +181,49 @@ func TestPrometheusExporter(t *testing.T) {
 	compareExport(t, exporter, expected)
 }
 
+type errorCatcher struct {
+	sync.Mutex
+	errors []error
+}
+
+func (e *errorCatcher) Handle(err error) {
+	e.Lock()
+	defer e.Unlock()
+	e.errors = append(e.errors, err)
+}
+
+func TestPrometheusExponentialHistogramUnsupported(t *testing.T) {
+	catcher := &errorCatcher{}
+	otel.SetErrorHandler(catcher)
+
+	c := controller.New(
+		processor.NewFactory(
+			selector.NewWithExponentialHistogramDistribution(),
+			aggregation.CumulativeTemporalitySelector(),
+			processor.WithMemory(true),
+		),
+		controller.WithCollectPeriod(0),
+		controller.WithResource(resource.Empty()),
+	)
+	exporter, err := prometheus.New(prometheus.Config{}, c)
+	require.NoError(t, err)
+
+	hist, err := exporter.MeterProvider().Meter("test").SyncFloat64().Histogram("lat")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	hist.Record(ctx, 1.5)
+	hist.Record(ctx, 3)
+
+	// The histogram is not published as a gauge.
+	compareExport(t, exporter, nil)
+
+	catcher.Lock()
+	defer catcher.Unlock()
+	require.Len(t, catcher.errors, 1)
+	require.ErrorIs(t, catcher.errors[0], prometheus.ErrUnsupportedAggregator)
+}
+
 func compareExport(t *testing.T, exporter *prometheus.Exporter, expected []expectedMetric) {
 	rec := httptest.NewRecorder()
 	req := httptest.NewRequest("GET", "/metrics", nil)
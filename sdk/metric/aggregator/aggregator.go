@@ -94,14 +94,19 @@ func NewInconsistentAggregatorError(a1, a2 Aggregator) error {
 }
 
 // RangeTest is a common routine for testing for valid input values.
-// This rejects NaN values.  This rejects negative values when the
+// This rejects NaN and infinite values.  This rejects negative values when the
 // metric instrument does not support negative values, including
 // monotonic counter metrics and absolute Histogram metrics.
 func RangeTest(num number.Number, descriptor *sdkapi.Descriptor) error {
 	numberKind := descriptor.NumberKind()
 
-	if numberKind == number.Float64Kind && math.IsNaN(num.AsFloat64()) {
-		return aggregation.ErrNaNInput
+	if numberKind == number.Float64Kind {
+		if math.IsNaN(num.AsFloat64()) {
+			return aggregation.ErrNaNInput
+		}
+		if math.IsInf(num.AsFloat64(), 0) {
+			return aggregation.ErrInfInput
+		}
 	}
 
 	switch descriptor.InstrumentKind() {
@@ -52,6 +52,20 @@ func testRangeNaN(t *testing.T, desc *sdkapi.Descriptor) {
 	}
 }
 
+func testRangeInf(t *testing.T, desc *sdkapi.Descriptor) {
+	// If the descriptor uses int64 numbers, this won't register as Inf
+	for _, sign := range []int{+1, -1} {
+		inf := number.NewFloat64Number(math.Inf(sign))
+		err := aggregator.RangeTest(inf, desc)
+
+		if desc.NumberKind() == number.Float64Kind {
+			require.Equal(t, aggregation.ErrInfInput, err)
+		} else {
+			require.NotEqual(t, aggregation.ErrInfInput, err)
+		}
+	}
+}
+
 func testRangeNegative(t *testing.T, desc *sdkapi.Descriptor) {
 	var neg, pos number.Number
 
@@ -102,3 +116,22 @@ func TestNaNTest(t *testing.T) {
 		})
 	}
 }
+
+func TestInfTest(t *testing.T) {
+	for _, nkind := range []number.Kind{number.Float64Kind, number.Int64Kind} {
+		t.Run(nkind.String(), func(t *testing.T) {
+			for _, mkind := range []sdkapi.InstrumentKind{
+				sdkapi.CounterInstrumentKind,
+				sdkapi.HistogramInstrumentKind,
+				sdkapi.GaugeObserverInstrumentKind,
+			} {
+				desc := metrictest.NewDescriptor(
+					"name",
+					mkind,
+					nkind,
+				)
+				testRangeInf(t, &desc)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exponential // import "go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+
+import (
+	"sort"
+
+	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/number"
+)
+
+// explicit is an explicit-boundary histogram computed from an
+// exponential histogram by ToExplicit.
+type explicit struct {
+	count   uint64
+	sum     number.Number
+	buckets aggregation.Buckets
+}
+
+var _ aggregation.Histogram = &explicit{}
+
+// ToExplicit converts an exponential histogram into a histogram with
+// the given explicit boundaries, so that one exponential aggregator
+// can be exported to readers that only support explicit buckets.
+//
+// Each exponential bucket is indivisible, so its whole count goes to
+// the explicit bucket holding the part of it nearest zero.  Zeros go
+// to the explicit bucket holding zero.  The count and sum are carried
+// over unchanged.  The result is exact when every boundary is a
+// bucket boundary of h, such as a power of 2 at scale 0, except for
+// negative values lying exactly on a boundary, which the exponential
+// histogram counts below it and an explicit histogram above it.
+func ToExplicit(h aggregation.ExponentialHistogram, boundaries []float64) (aggregation.Histogram, error) {
+	count, err := h.Count()
+	if err != nil {
+		return nil, err
+	}
+	sum, err := h.Sum()
+	if err != nil {
+		return nil, err
+	}
+	scale, err := h.Scale()
+	if err != nil {
+		return nil, err
+	}
+	zeros, err := h.ZeroCount()
+	if err != nil {
+		return nil, err
+	}
+	positive, err := h.Positive()
+	if err != nil {
+		return nil, err
+	}
+	negative, err := h.Negative()
+	if err != nil {
+		return nil, err
+	}
+	m, err := newMapping(scale)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := make([]float64, len(boundaries))
+	copy(sorted, boundaries)
+	sort.Float64s(sorted)
+
+	counts := make([]uint64, len(sorted)+1)
+	// Values just above lower belong to the first bucket whose
+	// boundary is greater than lower.
+	above := func(lower float64) int {
+		return sort.Search(len(sorted), func(i int) bool {
+			return sorted[i] > lower
+		})
+	}
+	// Values just below upper belong to the first bucket whose
+	// boundary is at least upper.
+	below := func(upper float64) int {
+		return sort.Search(len(sorted), func(i int) bool {
+			return sorted[i] >= upper
+		})
+	}
+
+	counts[above(0)] += zeros
+	for pos := uint32(0); pos < positive.Len(); pos++ {
+		lower, err := m.LowerBoundary(positive.Offset() + int32(pos))
+		if err != nil {
+			return nil, err
+		}
+		counts[above(lower)] += positive.At(pos)
+	}
+	for pos := uint32(0); pos < negative.Len(); pos++ {
+		lower, err := m.LowerBoundary(negative.Offset() + int32(pos))
+		if err != nil {
+			return nil, err
+		}
+		counts[below(-lower)] += negative.At(pos)
+	}
+
+	return &explicit{
+		count: count,
+		sum:   sum,
+		buckets: aggregation.Buckets{
+			Boundaries: sorted,
+			Counts:     counts,
+		},
+	}, nil
+}
+
+// Kind returns aggregation.HistogramKind.
+func (e *explicit) Kind() aggregation.Kind {
+	return aggregation.HistogramKind
+}
+
+// Count returns the number of values converted.
+func (e *explicit) Count() (uint64, error) {
+	return e.count, nil
+}
+
+// Sum returns the sum of the values converted.
+func (e *explicit) Sum() (number.Number, error) {
+	return e.sum, nil
+}
+
+// Histogram returns the converted bucket counts.
+func (e *explicit) Histogram() (aggregation.Buckets, error) {
+	return e.buckets, nil
+}
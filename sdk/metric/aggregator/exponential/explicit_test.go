@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exponential_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
+	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/number"
+	"go.opentelemetry.io/otel/sdk/metric/sdkapi"
+)
+
+// Test that boundaries aligned with the exponential buckets give the
+// same counts as an explicit histogram recording the same values.
+func TestToExplicitAligned(t *testing.T) {
+	ctx := context.Background()
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+	boundaries := []float64{8, -4, -1, 0, 0.5, 2}
+
+	agg, ckpt := new2(descriptor, exponential.WithMaxScale(0))
+	hagg := &histogram.New(1, descriptor, histogram.WithExplicitBoundaries(boundaries))[0]
+
+	// Negative powers of 2 are left out: they are the one case
+	// the two histograms count differently.
+	values := []float64{0, 0, 1, 8, -0.3, 100}
+	for i := 0; i < 1000; i++ {
+		values = append(values, rand.NormFloat64()*5)
+	}
+	for _, x := range values {
+		n := number.NewFloat64Number(x)
+		require.NoError(t, agg.Update(ctx, n, descriptor))
+		require.NoError(t, hagg.Update(ctx, n, descriptor))
+	}
+	require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+
+	scale, err := ckpt.Scale()
+	require.NoError(t, err)
+	require.Equal(t, int32(0), scale)
+
+	conv, err := exponential.ToExplicit(ckpt, boundaries)
+	require.NoError(t, err)
+	require.Equal(t, aggregation.HistogramKind, conv.Kind())
+
+	expect, err := hagg.Histogram()
+	require.NoError(t, err)
+	got, err := conv.Histogram()
+	require.NoError(t, err)
+	require.Equal(t, expect, got)
+}
+
+// Test that the count and sum are conserved for boundaries that do
+// not line up with the exponential buckets.
+func TestToExplicitConservation(t *testing.T) {
+	ctx := context.Background()
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+	boundaries := []float64{-3.3, 0.7, 5, 100}
+
+	agg, ckpt := new2(descriptor)
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, agg.Update(ctx, number.NewFloat64Number(rand.NormFloat64()*50), descriptor))
+	}
+	require.NoError(t, agg.Update(ctx, number.NewFloat64Number(0), descriptor))
+	require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+
+	conv, err := exponential.ToExplicit(ckpt, boundaries)
+	require.NoError(t, err)
+
+	count, err := conv.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1001), count)
+
+	expectSum, err := ckpt.Sum()
+	require.NoError(t, err)
+	sum, err := conv.Sum()
+	require.NoError(t, err)
+	require.Equal(t, expectSum, sum)
+
+	buckets, err := conv.Histogram()
+	require.NoError(t, err)
+	require.Equal(t, boundaries, buckets.Boundaries)
+	require.Len(t, buckets.Counts, len(boundaries)+1)
+	var total uint64
+	for _, c := range buckets.Counts {
+		total += c
+	}
+	require.Equal(t, count, total)
+}
+
+// Test that an empty histogram converts to empty buckets.
+func TestToExplicitEmpty(t *testing.T) {
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+	agg := &exponential.New(1, descriptor)[0]
+
+	conv, err := exponential.ToExplicit(agg, []float64{1, 2})
+	require.NoError(t, err)
+
+	buckets, err := conv.Histogram()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 0, 0}, buckets.Counts)
+}
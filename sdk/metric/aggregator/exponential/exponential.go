@@ -0,0 +1,510 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exponential // import "go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"go.opentelemetry.io/otel/sdk/metric/aggregator"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential/mapping"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential/mapping/exponent"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential/mapping/logarithm"
+	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/number"
+	"go.opentelemetry.io/otel/sdk/metric/sdkapi"
+)
+
+const (
+	// DefaultMaxSize is the default maximum number of buckets
+	// for each of the positive and negative ranges.
+	DefaultMaxSize int32 = 160
+
+	// MinSize is the smallest reasonable configuration, which
+	// fits the entire float64 range at the minimum scale.
+	MinSize int32 = 2
+
+	// DefaultMaxScale is the default, highest scale used before
+	// any measurements are recorded.
+	DefaultMaxScale = logarithm.MaxScale
+)
+
+type (
+	// Aggregator observes events and counts them in base-2
+	// exponential buckets, lowering the scale as needed to keep
+	// the number of buckets within a maximum size.  It also
+	// calculates the sum, count, minimum and maximum of all events
+	// and counts the events that were exactly zero.
+	Aggregator struct {
+		lock     sync.Mutex
+		maxSize  int32
+		maxScale int32
+		mapping  mapping.Mapping
+		state    *state
+	}
+
+	// config describes how the histogram is aggregated.
+	config struct {
+		maxSize  int32
+		maxScale int32
+	}
+
+	// Option configures a histogram config.
+	Option interface {
+		// apply sets one or more config fields.
+		apply(*config)
+	}
+
+	// state represents the state of a histogram, consisting of
+	// the sum, count, minimum and maximum of all observed values,
+	// the count of exactly zero values, the current scale and the
+	// positive and negative bucket counts at that scale.
+	state struct {
+		sum       number.Number
+		count     uint64
+		zeroCount uint64
+		min       number.Number
+		max       number.Number
+
+		scale    int32
+		mapping  mapping.Mapping
+		positive buckets
+		negative buckets
+	}
+
+	// buckets are the consecutive bucket counts for one sign,
+	// starting at index offset.
+	buckets struct {
+		offset int32
+		counts []uint64
+	}
+)
+
+// WithMaxSize sets the maximum number of buckets for each of the
+// positive and negative ranges.  Sizes smaller than MinSize are
+// raised to MinSize.
+func WithMaxSize(size int32) Option {
+	return maxSizeOption(size)
+}
+
+type maxSizeOption int32
+
+func (o maxSizeOption) apply(config *config) {
+	config.maxSize = int32(o)
+}
+
+// WithMaxScale sets the scale used before any measurements are
+// recorded, which is the highest resolution the histogram reaches.
+// Scales outside the supported range, from exponent.MinScale to
+// logarithm.MaxScale, are clamped to it.
+func WithMaxScale(scale int32) Option {
+	return maxScaleOption(scale)
+}
+
+type maxScaleOption int32
+
+func (o maxScaleOption) apply(config *config) {
+	config.maxScale = int32(o)
+}
+
+var _ aggregator.Aggregator = &Aggregator{}
+var _ aggregation.Sum = &Aggregator{}
+var _ aggregation.Count = &Aggregator{}
+var _ aggregation.Min = &Aggregator{}
+var _ aggregation.Max = &Aggregator{}
+var _ aggregation.ZeroCount = &Aggregator{}
+var _ aggregation.ExponentialHistogram = &Aggregator{}
+var _ aggregation.ExponentialBuckets = &buckets{}
+
+// New returns a new aggregator for computing base-2 exponential
+// histograms.
+func New(cnt int, desc *sdkapi.Descriptor, opts ...Option) []Aggregator {
+	cfg := config{
+		maxSize:  DefaultMaxSize,
+		maxScale: DefaultMaxScale,
+	}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	if cfg.maxSize < MinSize {
+		cfg.maxSize = MinSize
+	}
+	if cfg.maxScale < exponent.MinScale {
+		cfg.maxScale = exponent.MinScale
+	} else if cfg.maxScale > logarithm.MaxScale {
+		cfg.maxScale = logarithm.MaxScale
+	}
+
+	// The scale is within range, so this does not fail.
+	m, _ := newMapping(cfg.maxScale)
+
+	aggs := make([]Aggregator, cnt)
+	for i := range aggs {
+		aggs[i] = Aggregator{
+			maxSize:  cfg.maxSize,
+			maxScale: cfg.maxScale,
+			mapping:  m,
+		}
+		aggs[i].state = aggs[i].newState()
+	}
+	return aggs
+}
+
+// newMapping returns the mapping function for scale.
+func newMapping(scale int32) (mapping.Mapping, error) {
+	if scale <= 0 {
+		return exponent.NewMapping(scale)
+	}
+	return logarithm.NewMapping(scale)
+}
+
+// Aggregation returns an interface for reading the state of this aggregator.
+func (c *Aggregator) Aggregation() aggregation.Aggregation {
+	return c
+}
+
+// Kind returns aggregation.ExponentialHistogramKind.
+func (c *Aggregator) Kind() aggregation.Kind {
+	return aggregation.ExponentialHistogramKind
+}
+
+// Sum returns the sum of all values in the checkpoint.
+func (c *Aggregator) Sum() (number.Number, error) {
+	return c.state.sum, nil
+}
+
+// Count returns the number of values in the checkpoint.
+func (c *Aggregator) Count() (uint64, error) {
+	return c.state.count, nil
+}
+
+// ZeroCount returns the number of values in the checkpoint that were
+// exactly zero.
+func (c *Aggregator) ZeroCount() (uint64, error) {
+	return c.state.zeroCount, nil
+}
+
+// Min returns the minimum value in the checkpoint.  The error value
+// aggregation.ErrNoData will be returned if there were no
+// measurements recorded during the checkpoint.
+func (c *Aggregator) Min() (number.Number, error) {
+	if c.state.count == 0 {
+		return 0, aggregation.ErrNoData
+	}
+	return c.state.min, nil
+}
+
+// Max returns the maximum value in the checkpoint.  The error value
+// aggregation.ErrNoData will be returned if there were no
+// measurements recorded during the checkpoint.
+func (c *Aggregator) Max() (number.Number, error) {
+	if c.state.count == 0 {
+		return 0, aggregation.ErrNoData
+	}
+	return c.state.max, nil
+}
+
+// Scale returns the scale of the buckets in the checkpoint.
+func (c *Aggregator) Scale() (int32, error) {
+	return c.state.scale, nil
+}
+
+// Positive returns the buckets of positive values in the checkpoint.
+func (c *Aggregator) Positive() (aggregation.ExponentialBuckets, error) {
+	return &c.state.positive, nil
+}
+
+// Negative returns the buckets of negative values in the checkpoint,
+// indexed by their absolute value.
+func (c *Aggregator) Negative() (aggregation.ExponentialBuckets, error) {
+	return &c.state.negative, nil
+}
+
+// SynchronizedMove saves the current state into oa and resets the
+// current state to the empty set.
+func (c *Aggregator) SynchronizedMove(oa aggregator.Aggregator, desc *sdkapi.Descriptor) error {
+	o, _ := oa.(*Aggregator)
+
+	if oa != nil && o == nil {
+		return aggregator.NewInconsistentAggregatorError(c, oa)
+	}
+
+	if o != nil {
+		// Swap case: Reset the target state before swapping
+		// it under the lock below.
+		o.clearState()
+	}
+
+	c.lock.Lock()
+	if o != nil {
+		c.state, o.state = o.state, c.state
+	} else {
+		// No swap case: the asynchronous instrument case.
+		c.clearState()
+	}
+	c.lock.Unlock()
+
+	return nil
+}
+
+func (c *Aggregator) newState() *state {
+	return &state{
+		scale:   c.maxScale,
+		mapping: c.mapping,
+	}
+}
+
+func (c *Aggregator) clearState() {
+	c.state.sum = 0
+	c.state.count = 0
+	c.state.zeroCount = 0
+	c.state.min = 0
+	c.state.max = 0
+	c.state.scale = c.maxScale
+	c.state.mapping = c.mapping
+	c.state.positive.clear()
+	c.state.negative.clear()
+}
+
+// Update adds the recorded measurement to the current data set.
+func (c *Aggregator) Update(_ context.Context, n number.Number, desc *sdkapi.Descriptor) error {
+	kind := desc.NumberKind()
+	value := n.CoerceToFloat64(kind)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	s := c.state
+	if s.count == 0 || s.min.CompareNumber(kind, n) > 0 {
+		s.min = n
+	}
+	if s.count == 0 || s.max.CompareNumber(kind, n) < 0 {
+		s.max = n
+	}
+	s.count++
+	s.sum.AddNumber(kind, n)
+
+	if value == 0 {
+		s.zeroCount++
+		return nil
+	}
+
+	b := &s.positive
+	if value < 0 {
+		b = &s.negative
+		value = -value
+	}
+
+	index := s.mapToIndex(value)
+	if !b.empty() {
+		low, high := b.offset, b.high()
+		if index < low {
+			low = index
+		} else if index > high {
+			high = index
+		}
+		if change := changeFor(low, high, c.maxSize); change != 0 {
+			if err := s.downscale(change); err != nil {
+				return err
+			}
+			index >>= change
+		}
+	}
+	b.increment(index, 1)
+	return nil
+}
+
+// Merge combines two exponential histograms into one, at the highest
+// scale at which both fit within the maximum size.
+func (c *Aggregator) Merge(oa aggregator.Aggregator, desc *sdkapi.Descriptor) error {
+	o, _ := oa.(*Aggregator)
+	if o == nil {
+		return aggregator.NewInconsistentAggregatorError(c, oa)
+	}
+
+	if o.state.count == 0 {
+		return nil
+	}
+
+	s, os := c.state, o.state
+
+	kind := desc.NumberKind()
+	if s.count == 0 || s.min.CompareNumber(kind, os.min) > 0 {
+		s.min = os.min
+	}
+	if s.count == 0 || s.max.CompareNumber(kind, os.max) < 0 {
+		s.max = os.max
+	}
+	s.sum.AddNumber(kind, os.sum)
+	s.count += os.count
+	s.zeroCount += os.zeroCount
+
+	scale := s.scale
+	if os.scale < scale {
+		scale = os.scale
+	}
+	posChange := mergeChange(&s.positive, s.scale-scale, &os.positive, os.scale-scale, c.maxSize)
+	negChange := mergeChange(&s.negative, s.scale-scale, &os.negative, os.scale-scale, c.maxSize)
+	if negChange > posChange {
+		posChange = negChange
+	}
+	scale -= posChange
+
+	if scale < s.scale {
+		if err := s.downscale(s.scale - scale); err != nil {
+			return err
+		}
+	}
+	s.positive.mergeFrom(&os.positive, os.scale-scale)
+	s.negative.mergeFrom(&os.negative, os.scale-scale)
+	return nil
+}
+
+// mapToIndex maps value to its bucket index at the current scale.
+// The logarithm mapping may place an exact power of two one bucket
+// low, and shifting the index to a lower scale keeps that error, so
+// normal powers of two are mapped from their exponent, which is the
+// lower boundary of their bucket at every scale.
+func (s *state) mapToIndex(value float64) int32 {
+	if frac, exp := math.Frexp(value); frac == 0.5 && value >= 0x1p-1022 {
+		index := int32(exp - 1)
+		if s.scale > 0 {
+			return index << s.scale
+		}
+		return index >> -s.scale
+	}
+	return s.mapping.MapToIndex(value)
+}
+
+// downscale lowers the scale of s by change, combining buckets.
+func (s *state) downscale(change int32) error {
+	m, err := newMapping(s.scale - change)
+	if err != nil {
+		return err
+	}
+	s.positive.downscale(change)
+	s.negative.downscale(change)
+	s.scale -= change
+	s.mapping = m
+	return nil
+}
+
+// changeFor returns how much the scale must be lowered for the
+// indexes from low to high to fit within maxSize buckets.
+func changeFor(low, high, maxSize int32) int32 {
+	var change int32
+	for int64(high)-int64(low) >= int64(maxSize) {
+		low >>= 1
+		high >>= 1
+		change++
+	}
+	return change
+}
+
+// mergeChange returns how much the scale must be lowered for a and
+// b to fit together within maxSize buckets, after first lowering
+// their scales by aChange and bChange respectively.
+func mergeChange(a *buckets, aChange int32, b *buckets, bChange int32, maxSize int32) int32 {
+	switch {
+	case a.empty() && b.empty():
+		return 0
+	case a.empty():
+		return changeFor(b.offset>>bChange, b.high()>>bChange, maxSize)
+	case b.empty():
+		return changeFor(a.offset>>aChange, a.high()>>aChange, maxSize)
+	}
+	low, high := a.offset>>aChange, a.high()>>aChange
+	if bl := b.offset >> bChange; bl < low {
+		low = bl
+	}
+	if bh := b.high() >> bChange; bh > high {
+		high = bh
+	}
+	return changeFor(low, high, maxSize)
+}
+
+// Offset implements aggregation.ExponentialBuckets.
+func (b *buckets) Offset() int32 {
+	return b.offset
+}
+
+// Len implements aggregation.ExponentialBuckets.
+func (b *buckets) Len() uint32 {
+	return uint32(len(b.counts))
+}
+
+// At implements aggregation.ExponentialBuckets.
+func (b *buckets) At(pos uint32) uint64 {
+	return b.counts[pos]
+}
+
+func (b *buckets) empty() bool {
+	return len(b.counts) == 0
+}
+
+// high returns the index of the last bucket.
+func (b *buckets) high() int32 {
+	return b.offset + int32(len(b.counts)) - 1
+}
+
+func (b *buckets) clear() {
+	b.offset = 0
+	b.counts = b.counts[:0]
+}
+
+// increment adds cnt to the bucket at index, growing b as needed.
+// The caller ensures that the result fits within the maximum size.
+func (b *buckets) increment(index int32, cnt uint64) {
+	switch {
+	case b.empty():
+		b.offset = index
+		b.counts = append(b.counts, 0)
+	case index < b.offset:
+		grow := b.offset - index
+		counts := make([]uint64, int32(len(b.counts))+grow)
+		copy(counts[grow:], b.counts)
+		b.offset, b.counts = index, counts
+	case index > b.high():
+		b.counts = append(b.counts, make([]uint64, index-b.high())...)
+	}
+	b.counts[index-b.offset] += cnt
+}
+
+// downscale lowers the scale of b by change, combining buckets
+// whose indexes share the same value shifted right by change.
+func (b *buckets) downscale(change int32) {
+	if change == 0 || b.empty() {
+		return
+	}
+	offset := b.offset >> change
+	counts := make([]uint64, b.high()>>change-offset+1)
+	for i, cnt := range b.counts {
+		counts[(b.offset+int32(i))>>change-offset] += cnt
+	}
+	b.offset, b.counts = offset, counts
+}
+
+// mergeFrom adds the counts of o to b, lowering their scale by
+// change.  The caller ensures that the result fits within the
+// maximum size.
+func (b *buckets) mergeFrom(o *buckets, change int32) {
+	for i, cnt := range o.counts {
+		if cnt != 0 {
+			b.increment((o.offset+int32(i))>>change, cnt)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exponential_test
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential/mapping"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential/mapping/exponent"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential/mapping/logarithm"
+	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/number"
+	"go.opentelemetry.io/otel/sdk/metric/sdkapi"
+)
+
+func new2(desc *sdkapi.Descriptor, options ...exponential.Option) (_, _ *exponential.Aggregator) {
+	alloc := exponential.New(2, desc, options...)
+	return &alloc[0], &alloc[1]
+}
+
+func new4(desc *sdkapi.Descriptor, options ...exponential.Option) (_, _, _, _ *exponential.Aggregator) {
+	alloc := exponential.New(4, desc, options...)
+	return &alloc[0], &alloc[1], &alloc[2], &alloc[3]
+}
+
+func newMapping(t *testing.T, scale int32) mapping.Mapping {
+	var m mapping.Mapping
+	var err error
+	if scale <= 0 {
+		m, err = exponent.NewMapping(scale)
+	} else {
+		m, err = logarithm.NewMapping(scale)
+	}
+	require.NoError(t, err)
+	return m
+}
+
+// indexFor returns the bucket index of x at the scale of m.  Exact
+// powers of two are the lower boundary of their bucket, which the
+// logarithm mapping may miss by one.
+func indexFor(m mapping.Mapping, x float64) int32 {
+	if frac, exp := math.Frexp(x); frac == 0.5 {
+		if scale := m.Scale(); scale > 0 {
+			return int32(exp-1) << scale
+		}
+		return int32(exp-1) >> -m.Scale()
+	}
+	return m.MapToIndex(x)
+}
+
+// bucketCounts returns the counts of b indexed by bucket index.
+func bucketCounts(b aggregation.ExponentialBuckets) map[int32]uint64 {
+	counts := map[int32]uint64{}
+	for i := uint32(0); i < b.Len(); i++ {
+		if c := b.At(i); c != 0 {
+			counts[b.Offset()+int32(i)] = c
+		}
+	}
+	return counts
+}
+
+func checkZero(t *testing.T, agg *exponential.Aggregator) {
+	count, err := agg.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), count)
+
+	zeros, err := agg.ZeroCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), zeros)
+
+	scale, err := agg.Scale()
+	require.NoError(t, err)
+	require.Equal(t, exponential.DefaultMaxScale, scale)
+
+	_, err = agg.Min()
+	require.ErrorIs(t, err, aggregation.ErrNoData)
+
+	pos, err := agg.Positive()
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), pos.Len())
+
+	neg, err := agg.Negative()
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), neg.Len())
+}
+
+func TestExponentialDownscale(t *testing.T) {
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+
+	agg, ckpt := new2(descriptor, exponential.WithMaxSize(2))
+
+	// At scale 0 these map to indexes 0, 1 and 2, which need scale
+	// -1 to fit in two buckets.
+	for _, x := range []float64{1.5, 3, 6} {
+		aggregatortest.CheckedUpdate(t, agg, number.NewFloat64Number(x), descriptor)
+	}
+	require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+
+	scale, err := ckpt.Scale()
+	require.NoError(t, err)
+	require.Equal(t, int32(-1), scale)
+
+	pos, err := ckpt.Positive()
+	require.NoError(t, err)
+	require.Equal(t, map[int32]uint64{0: 2, 1: 1}, bucketCounts(pos))
+
+	checkZero(t, agg)
+}
+
+// Test that exact powers of two recorded at the maximum scale land in
+// the bucket whose boundaries contain them after downscaling.
+func TestExponentialPowersOfTwo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("int64", func(t *testing.T) {
+		descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Int64Kind)
+		agg, _ := new2(descriptor, exponential.WithMaxSize(4))
+
+		for _, x := range []int64{8, 2, 12} {
+			require.NoError(t, agg.Update(ctx, number.NewInt64Number(x), descriptor))
+		}
+
+		scale, err := agg.Scale()
+		require.NoError(t, err)
+		require.Equal(t, int32(0), scale)
+
+		pos, err := agg.Positive()
+		require.NoError(t, err)
+		require.Equal(t, map[int32]uint64{1: 1, 3: 2}, bucketCounts(pos))
+
+		lower, err := newMapping(t, scale).LowerBoundary(3)
+		require.NoError(t, err)
+		require.Equal(t, 8.0, lower)
+	})
+
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+	for k := -20; k <= 60; k++ {
+		agg, _ := new2(descriptor, exponential.WithMaxSize(2))
+
+		// The second value forces the scale down to -1.
+		values := []float64{math.Ldexp(1, k), math.Ldexp(1, k+2)}
+		for _, x := range values {
+			require.NoError(t, agg.Update(ctx, number.NewFloat64Number(x), descriptor))
+		}
+
+		scale, err := agg.Scale()
+		require.NoError(t, err)
+		require.LessOrEqual(t, scale, int32(0))
+
+		m := newMapping(t, scale)
+		expect := map[int32]uint64{}
+		for _, x := range values {
+			index := m.MapToIndex(x)
+			lower, err := m.LowerBoundary(index)
+			require.NoError(t, err)
+			upper, err := m.LowerBoundary(index + 1)
+			require.NoError(t, err)
+			require.LessOrEqual(t, lower, x, "2**%d", k)
+			require.Less(t, x, upper, "2**%d", k)
+			expect[index]++
+		}
+
+		pos, err := agg.Positive()
+		require.NoError(t, err)
+		require.Equal(t, expect, bucketCounts(pos), "2**%d", k)
+	}
+}
+
+func TestExponentialScaling(t *testing.T) {
+	aggregatortest.RunProfiles(t, func(t *testing.T, profile aggregatortest.Profile) {
+		descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, profile.NumberKind)
+
+		const maxSize = 20
+		agg, ckpt := new2(descriptor, exponential.WithMaxSize(maxSize))
+
+		all := aggregatortest.NewNumbers(profile.NumberKind)
+		for i := 0; i < 1000; i++ {
+			x := profile.Random(+1)
+			if x.IsZero(profile.NumberKind) {
+				continue
+			}
+			all.Append(x)
+			aggregatortest.CheckedUpdate(t, agg, x, descriptor)
+		}
+		require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+		all.Sort()
+
+		scale, err := ckpt.Scale()
+		require.NoError(t, err)
+		require.Less(t, scale, exponential.DefaultMaxScale)
+
+		pos, err := ckpt.Positive()
+		require.NoError(t, err)
+		require.LessOrEqual(t, pos.Len(), uint32(maxSize))
+
+		// Every value is counted in the bucket that the mapping
+		// at the final scale assigns to it.
+		m := newMapping(t, scale)
+		expect := map[int32]uint64{}
+		for _, x := range all.Points() {
+			expect[indexFor(m, x.CoerceToFloat64(profile.NumberKind))]++
+		}
+		require.Equal(t, expect, bucketCounts(pos))
+
+		count, err := ckpt.Count()
+		require.NoError(t, err)
+		require.Equal(t, all.Count(), count)
+
+		asum, err := ckpt.Sum()
+		require.NoError(t, err)
+		sum := all.Sum()
+		require.InEpsilon(t, sum.CoerceToFloat64(profile.NumberKind), asum.CoerceToFloat64(profile.NumberKind), 1e-9)
+
+		amin, err := ckpt.Min()
+		require.NoError(t, err)
+		require.Equal(t, all.Min(), amin)
+
+		amax, err := ckpt.Max()
+		require.NoError(t, err)
+		require.Equal(t, all.Max(), amax)
+	})
+}
+
+func TestExponentialSignsAndZeros(t *testing.T) {
+	ctx := context.Background()
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+
+	agg, ckpt := new2(descriptor)
+
+	for _, x := range []float64{-1.5, 0, math.Copysign(0, -1), 1.5, 1.5} {
+		require.NoError(t, agg.Update(ctx, number.NewFloat64Number(x), descriptor))
+	}
+	require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+
+	zeros, err := ckpt.ZeroCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), zeros)
+
+	scale, err := ckpt.Scale()
+	require.NoError(t, err)
+	require.Equal(t, exponential.DefaultMaxScale, scale)
+
+	index := newMapping(t, scale).MapToIndex(1.5)
+
+	pos, err := ckpt.Positive()
+	require.NoError(t, err)
+	require.Equal(t, map[int32]uint64{index: 2}, bucketCounts(pos))
+
+	neg, err := ckpt.Negative()
+	require.NoError(t, err)
+	require.Equal(t, map[int32]uint64{index: 1}, bucketCounts(neg))
+}
+
+// Test that merging the checkpoints of several collection cycles
+// produces the same histogram as recording every value into one.
+func TestExponentialMergeCycles(t *testing.T) {
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+
+	const maxSize = 16
+	agg, ckpt, cumulative, all := new4(descriptor, exponential.WithMaxSize(maxSize))
+
+	rnd := rand.New(rand.NewSource(1))
+	for cycle := 0; cycle < 5; cycle++ {
+		// Each cycle widens the range of values, which lowers
+		// the scale of later checkpoints.
+		magnitude := math.Pow(10, float64(cycle))
+		for i := 0; i < 100; i++ {
+			x := number.NewFloat64Number((rnd.Float64()*2 - 1) * magnitude)
+			aggregatortest.CheckedUpdate(t, agg, x, descriptor)
+			aggregatortest.CheckedUpdate(t, all, x, descriptor)
+		}
+		require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+		aggregatortest.CheckedMerge(t, cumulative, ckpt, descriptor)
+	}
+
+	for _, pair := range []struct {
+		name string
+		get  func(*exponential.Aggregator) (interface{}, error)
+	}{
+		{"count", func(a *exponential.Aggregator) (interface{}, error) { return a.Count() }},
+		{"zeros", func(a *exponential.Aggregator) (interface{}, error) { return a.ZeroCount() }},
+		{"scale", func(a *exponential.Aggregator) (interface{}, error) { return a.Scale() }},
+		{"min", func(a *exponential.Aggregator) (interface{}, error) { return a.Min() }},
+		{"max", func(a *exponential.Aggregator) (interface{}, error) { return a.Max() }},
+		{"positive", func(a *exponential.Aggregator) (interface{}, error) {
+			b, err := a.Positive()
+			return bucketCounts(b), err
+		}},
+		{"negative", func(a *exponential.Aggregator) (interface{}, error) {
+			b, err := a.Negative()
+			return bucketCounts(b), err
+		}},
+	} {
+		expect, err := pair.get(all)
+		require.NoError(t, err)
+		got, err := pair.get(cumulative)
+		require.NoError(t, err)
+		require.Equal(t, expect, got, pair.name)
+	}
+
+	pos, err := cumulative.Positive()
+	require.NoError(t, err)
+	require.LessOrEqual(t, pos.Len(), uint32(maxSize))
+}
+
+func TestExponentialMergeEmpty(t *testing.T) {
+	ctx := context.Background()
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+
+	agg, empty := new2(descriptor)
+	require.NoError(t, agg.Update(ctx, number.NewFloat64Number(1.5), descriptor))
+
+	aggregatortest.CheckedMerge(t, agg, empty, descriptor)
+	aggregatortest.CheckedMerge(t, empty, agg, descriptor)
+
+	for _, h := range []*exponential.Aggregator{agg, empty} {
+		count, err := h.Count()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), count)
+
+		amin, err := h.Min()
+		require.NoError(t, err)
+		require.Equal(t, number.NewFloat64Number(1.5), amin)
+	}
+}
+
+func TestExponentialInconsistentMerge(t *testing.T) {
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+
+	agg, _ := new2(descriptor)
+	require.ErrorIs(t, agg.Merge(aggregatortest.NoopAggregator{}, descriptor), aggregation.ErrInconsistentType)
+	require.ErrorIs(t, agg.SynchronizedMove(aggregatortest.NoopAggregator{}, descriptor), aggregation.ErrInconsistentType)
+}
+
+func TestExponentialOptions(t *testing.T) {
+	ctx := context.Background()
+	descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, number.Float64Kind)
+
+	// Out of range options are clamped.
+	agg, _ := new2(descriptor, exponential.WithMaxSize(0), exponential.WithMaxScale(100))
+	scale, err := agg.Scale()
+	require.NoError(t, err)
+	require.Equal(t, logarithm.MaxScale, scale)
+
+	// The smallest configuration fits the full float64 range.
+	for _, x := range []float64{math.SmallestNonzeroFloat64, 1.5, math.MaxFloat64} {
+		require.NoError(t, agg.Update(ctx, number.NewFloat64Number(x), descriptor))
+	}
+	scale, err = agg.Scale()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, scale, exponent.MinScale)
+
+	pos, err := agg.Positive()
+	require.NoError(t, err)
+	require.LessOrEqual(t, pos.Len(), uint32(exponential.MinSize))
+}
@@ -32,7 +32,8 @@ import (
 
 type (
 	// Aggregator observe events and counts them in pre-determined buckets.
-	// It also calculates the sum and count of all events.
+	// It also calculates the sum, count, minimum and maximum of all events,
+	// and counts the events that were exactly zero.
 	Aggregator struct {
 		lock       sync.Mutex
 		boundaries []float64
@@ -54,12 +55,16 @@ type (
 	}
 
 	// state represents the state of a histogram, consisting of
-	// the sum and counts for all observed values and
-	// the less than equal bucket count for the pre-determined boundaries.
+	// the sum, count, minimum and maximum of all observed values,
+	// the count of exactly zero values and the less than equal
+	// bucket count for the pre-determined boundaries.
 	state struct {
 		bucketCounts []uint64
 		sum          number.Number
 		count        uint64
+		zeroCount    uint64
+		min          number.Number
+		max          number.Number
 	}
 )
 
@@ -100,6 +105,9 @@ var _ aggregator.Aggregator = &Aggregator{}
 var _ aggregation.Sum = &Aggregator{}
 var _ aggregation.Count = &Aggregator{}
 var _ aggregation.Histogram = &Aggregator{}
+var _ aggregation.Min = &Aggregator{}
+var _ aggregation.Max = &Aggregator{}
+var _ aggregation.ZeroCount = &Aggregator{}
 
 // New returns a new aggregator for computing Histograms.
 //
@@ -161,6 +169,33 @@ func (c *Aggregator) Count() (uint64, error) {
 	return c.state.count, nil
 }
 
+// ZeroCount returns the number of values in the checkpoint that were
+// exactly zero.  These values are also counted in the bucket that
+// contains zero.
+func (c *Aggregator) ZeroCount() (uint64, error) {
+	return c.state.zeroCount, nil
+}
+
+// Min returns the minimum value in the checkpoint.  The error value
+// aggregation.ErrNoData will be returned if there were no
+// measurements recorded during the checkpoint.
+func (c *Aggregator) Min() (number.Number, error) {
+	if c.state.count == 0 {
+		return 0, aggregation.ErrNoData
+	}
+	return c.state.min, nil
+}
+
+// Max returns the maximum value in the checkpoint.  The error value
+// aggregation.ErrNoData will be returned if there were no
+// measurements recorded during the checkpoint.
+func (c *Aggregator) Max() (number.Number, error) {
+	if c.state.count == 0 {
+		return 0, aggregation.ErrNoData
+	}
+	return c.state.max, nil
+}
+
 // Histogram returns the count of events in pre-determined buckets.
 func (c *Aggregator) Histogram() (aggregation.Buckets, error) {
 	return aggregation.Buckets{
@@ -216,6 +251,9 @@ func (c *Aggregator) clearState() {
 	}
 	c.state.sum = 0
 	c.state.count = 0
+	c.state.zeroCount = 0
+	c.state.min = 0
+	c.state.max = 0
 }
 
 // Update adds the recorded measurement to the current data set.
@@ -245,7 +283,16 @@ func (c *Aggregator) Update(_ context.Context, n number.Number, desc *sdkapi.Des
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	if c.state.count == 0 || c.state.min.CompareNumber(kind, n) > 0 {
+		c.state.min = n
+	}
+	if c.state.count == 0 || c.state.max.CompareNumber(kind, n) < 0 {
+		c.state.max = n
+	}
 	c.state.count++
+	if n.IsZero(kind) {
+		c.state.zeroCount++
+	}
 	c.state.sum.AddNumber(kind, n)
 	c.state.bucketCounts[bucketID]++
 
@@ -259,8 +306,21 @@ func (c *Aggregator) Merge(oa aggregator.Aggregator, desc *sdkapi.Descriptor) er
 		return aggregator.NewInconsistentAggregatorError(c, oa)
 	}
 
-	c.state.sum.AddNumber(desc.NumberKind(), o.state.sum)
+	if o.state.count == 0 {
+		return nil
+	}
+
+	kind := desc.NumberKind()
+	if c.state.count == 0 || c.state.min.CompareNumber(kind, o.state.min) > 0 {
+		c.state.min = o.state.min
+	}
+	if c.state.count == 0 || c.state.max.CompareNumber(kind, o.state.max) < 0 {
+		c.state.max = o.state.max
+	}
+
+	c.state.sum.AddNumber(kind, o.state.sum)
 	c.state.count += o.state.count
+	c.state.zeroCount += o.state.zeroCount
 
 	for i := 0; i < len(c.state.bucketCounts); i++ {
 		c.state.bucketCounts[i] += o.state.bucketCounts[i]
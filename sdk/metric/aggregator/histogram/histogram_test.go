@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/aggregator"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
+	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/number"
 	"go.opentelemetry.io/otel/sdk/metric/sdkapi"
 )
@@ -82,6 +83,16 @@ func checkZero(t *testing.T, agg *histogram.Aggregator, desc *sdkapi.Descriptor)
 	require.Equal(t, uint64(0), count, "Empty checkpoint count = 0")
 	require.NoError(t, err)
 
+	zeros, err := agg.ZeroCount()
+	require.Equal(t, uint64(0), zeros, "Empty checkpoint zero count = 0")
+	require.NoError(t, err)
+
+	_, err = agg.Min()
+	require.ErrorIs(t, err, aggregation.ErrNoData)
+
+	_, err = agg.Max()
+	require.ErrorIs(t, err, aggregation.ErrNoData)
+
 	buckets, err := agg.Histogram()
 	require.NoError(t, err)
 
@@ -208,6 +219,14 @@ func checkHistogram(t *testing.T, all aggregatortest.Numbers, profile aggregator
 	require.NoError(t, err)
 	require.Equal(t, all.Count(), count)
 
+	amin, err := agg.Min()
+	require.NoError(t, err)
+	require.Equal(t, all.Min(), amin)
+
+	amax, err := agg.Max()
+	require.NoError(t, err)
+	require.Equal(t, all.Max(), amax)
+
 	buckets, err := agg.Histogram()
 	require.NoError(t, err)
 
@@ -293,3 +312,100 @@ func TestHistogramDefaultBoundaries(t *testing.T) {
 		require.EqualValues(t, expect, bucks.Counts)
 	})
 }
+
+func TestHistogramMinMaxSingleSample(t *testing.T) {
+	aggregatortest.RunProfiles(t, func(t *testing.T, profile aggregatortest.Profile) {
+		ctx := context.Background()
+		descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, profile.NumberKind)
+
+		agg, ckpt := new2(descriptor, histogram.WithExplicitBoundaries(testBoundaries))
+
+		x := profile.Random(-1)
+		require.NoError(t, agg.Update(ctx, x, descriptor))
+		require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+
+		amin, err := ckpt.Min()
+		require.NoError(t, err)
+		require.Equal(t, x, amin)
+
+		amax, err := ckpt.Max()
+		require.NoError(t, err)
+		require.Equal(t, x, amax)
+	})
+}
+
+func TestHistogramMinMaxMergeEmpty(t *testing.T) {
+	aggregatortest.RunProfiles(t, func(t *testing.T, profile aggregatortest.Profile) {
+		ctx := context.Background()
+		descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, profile.NumberKind)
+
+		agg, empty, ckpt, _ := new4(descriptor, histogram.WithExplicitBoundaries(testBoundaries))
+
+		neg := profile.Random(-1)
+		pos := profile.Random(+1)
+		require.NoError(t, agg.Update(ctx, neg, descriptor))
+		require.NoError(t, agg.Update(ctx, pos, descriptor))
+		require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+
+		// Merging an empty histogram in either direction
+		// preserves the minimum and maximum.
+		aggregatortest.CheckedMerge(t, ckpt, empty, descriptor)
+		aggregatortest.CheckedMerge(t, empty, ckpt, descriptor)
+
+		for _, h := range []*histogram.Aggregator{ckpt, empty} {
+			amin, err := h.Min()
+			require.NoError(t, err)
+			require.Equal(t, neg, amin)
+
+			amax, err := h.Max()
+			require.NoError(t, err)
+			require.Equal(t, pos, amax)
+		}
+	})
+}
+
+func TestHistogramZeroCount(t *testing.T) {
+	aggregatortest.RunProfiles(t, func(t *testing.T, profile aggregatortest.Profile) {
+		ctx := context.Background()
+		descriptor := aggregatortest.NewAggregatorTest(sdkapi.HistogramInstrumentKind, profile.NumberKind)
+
+		agg1, agg2, ckpt1, ckpt2 := new4(descriptor, histogram.WithExplicitBoundaries(testBoundaries))
+
+		zero := number.NewInt64Number(0)
+		one := number.NewInt64Number(1)
+		if profile.NumberKind == number.Float64Kind {
+			zero = number.NewFloat64Number(math.Copysign(0, -1))
+			one = number.NewFloat64Number(1)
+		}
+
+		for _, n := range []number.Number{zero, one, zero, one, one} {
+			require.NoError(t, agg1.Update(ctx, n, descriptor))
+		}
+		require.NoError(t, agg2.Update(ctx, zero, descriptor))
+		require.NoError(t, agg2.Update(ctx, one, descriptor))
+
+		require.NoError(t, agg1.SynchronizedMove(ckpt1, descriptor))
+		require.NoError(t, agg2.SynchronizedMove(ckpt2, descriptor))
+
+		zeros, err := ckpt1.ZeroCount()
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), zeros)
+
+		count, err := ckpt1.Count()
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), count)
+
+		aggregatortest.CheckedMerge(t, ckpt1, ckpt2, descriptor)
+
+		zeros, err = ckpt1.ZeroCount()
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), zeros)
+
+		// Zeros are still counted in the bucket containing zero.
+		buckets, err := ckpt1.Histogram()
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), buckets.Counts[0])
+
+		checkZero(t, agg1, descriptor)
+	})
+}
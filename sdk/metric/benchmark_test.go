@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -228,6 +229,32 @@ func BenchmarkFloat64CounterAdd(b *testing.B) {
 	}
 }
 
+// BenchmarkInt64CounterAddContended records to a single shared
+// attribute set from 16 goroutines, exercising the lock-free lookup of
+// existing records in Accumulator.current.
+func BenchmarkInt64CounterAddContended(b *testing.B) {
+	const goroutines = 16
+
+	ctx := context.Background()
+	fix := newFixture(b)
+	labs := makeAttrs(1)
+	cnt := fix.iCounter("int64.sum")
+
+	b.ResetTimer()
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				cnt.Add(ctx, 1, labs...)
+			}
+		}(b.N / goroutines)
+	}
+	wg.Wait()
+}
+
 // LastValue
 
 func BenchmarkInt64LastValueAdd(b *testing.B) {
@@ -62,6 +62,11 @@ type Controller struct {
 	libraries           sync.Map
 	checkpointerFactory export.CheckpointerFactory
 
+	// collectLock serializes collections, so that one checkpoint
+	// is exported before the next one begins.  It is held by the
+	// ticker, Stop(), Collect() and ForceFlush().
+	collectLock sync.Mutex
+
 	resource *resource.Resource
 	exporter export.Exporter
 	wg       sync.WaitGroup
@@ -73,8 +78,9 @@ type Controller struct {
 	collectTimeout time.Duration
 	pushTimeout    time.Duration
 
-	// collectedTime is used only in configurations with no
-	// exporter, when ticker != nil.
+	// collectedTime is the time of the last Collect() or
+	// ForceFlush(), used to skip a Collect() within the
+	// collection period.
 	collectedTime time.Time
 }
 
@@ -232,6 +238,9 @@ func (c *Controller) runTicker(ctx context.Context, stopCh chan struct{}) {
 
 // collect computes a checkpoint and optionally exports it.
 func (c *Controller) collect(ctx context.Context) error {
+	c.collectLock.Lock()
+	defer c.collectLock.Unlock()
+
 	if err := c.checkpoint(ctx); err != nil {
 		return err
 	}
@@ -360,9 +369,30 @@ func (c *Controller) Collect(ctx context.Context) error {
 		return nil
 	}
 
+	c.collectLock.Lock()
+	defer c.collectLock.Unlock()
 	return c.checkpoint(ctx)
 }
 
+// ForceFlush collects immediately, regardless of the configured
+// collection period, and exports the result when an exporter is
+// configured.  This may be called whether or not the controller was
+// started.  It waits for a collection in progress to finish, and a
+// following Collect() is skipped until the collection period has
+// passed again.  The passed context is passed to Collect() and
+// subsequently to the asynchronous instruments.
+func (c *Controller) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	c.collectedTime = c.clock.Now()
+	c.lock.Unlock()
+
+	return c.collect(ctx)
+}
+
 // shouldCollect returns true if the collector should collect now,
 // based on the timestamp, the last collection time, and the
 // configured period.
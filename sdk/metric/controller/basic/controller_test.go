@@ -249,6 +249,40 @@ func TestObserverCanceled(t *testing.T) {
 	require.EqualValues(t, expect, getMap(t, cont))
 }
 
+func TestObserverCanceledSkipsCallbacks(t *testing.T) {
+	cont := controller.New(
+		processor.NewFactory(
+			processortest.AggregatorSelector(),
+			aggregation.CumulativeTemporalitySelector(),
+		),
+		controller.WithCollectPeriod(0),
+		controller.WithCollectTimeout(time.Millisecond),
+		controller.WithResource(resource.Empty()),
+	)
+	meter := cont.Meter("go.opentelemetry.io/otel/sdk/metric/controller/basic_test#ObserverCanceledSkipsCallbacks")
+
+	calls := 0
+
+	for _, name := range []string{"a.lastvalue", "b.lastvalue"} {
+		counterObserver, err := meter.AsyncInt64().Counter(name)
+		require.NoError(t, err)
+
+		err = meter.RegisterCallback([]instrument.Asynchronous{counterObserver}, func(ctx context.Context) {
+			<-ctx.Done()
+			calls++
+			counterObserver.Observe(ctx, 1)
+		})
+		require.NoError(t, err)
+	}
+
+	// The first callback to run outlives the timeout, so the
+	// other one is skipped.
+	err := cont.Collect(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, calls)
+	require.Len(t, getMap(t, cont), 1)
+}
+
 func TestObserverContext(t *testing.T) {
 	cont := controller.New(
 		processor.NewFactory(
@@ -119,3 +119,79 @@ func TestPullWithCollect(t *testing.T) {
 		"counter.sum/A=B/": 20,
 	}, records.Map())
 }
+
+func TestPullForceFlushSkipsCache(t *testing.T) {
+	puller := controller.New(
+		processor.NewFactory(
+			processortest.AggregatorSelector(),
+			aggregation.CumulativeTemporalitySelector(),
+			processor.WithMemory(true),
+		),
+		controller.WithCollectPeriod(time.Second),
+		controller.WithResource(resource.Empty()),
+	)
+	mock := controllertest.NewMockClock()
+	puller.SetClock(mock)
+
+	ctx := context.Background()
+	meter := puller.Meter("nocache")
+	counter, err := meter.SyncInt64().Counter("counter.sum")
+	require.NoError(t, err)
+
+	counter.Add(ctx, 10, attribute.String("A", "B"))
+
+	require.NoError(t, puller.Collect(ctx))
+
+	counter.Add(ctx, 10, attribute.String("A", "B"))
+
+	// Not cached, despite the collection period.
+	require.NoError(t, puller.ForceFlush(ctx))
+	records := processortest.NewOutput(attribute.DefaultEncoder())
+	require.NoError(t, controllertest.ReadAll(puller, aggregation.CumulativeTemporalitySelector(), records.AddInstrumentationLibraryRecord))
+
+	require.EqualValues(t, map[string]float64{
+		"counter.sum/A=B/": 20,
+	}, records.Map())
+}
+
+func TestPullCollectAfterForceFlush(t *testing.T) {
+	puller := controller.New(
+		processor.NewFactory(
+			processortest.AggregatorSelector(),
+			aggregation.CumulativeTemporalitySelector(),
+			processor.WithMemory(true),
+		),
+		controller.WithCollectPeriod(time.Second),
+		controller.WithResource(resource.Empty()),
+	)
+	mock := controllertest.NewMockClock()
+	puller.SetClock(mock)
+
+	ctx := context.Background()
+	meter := puller.Meter("nocache")
+	counter, err := meter.SyncInt64().Counter("counter.sum")
+	require.NoError(t, err)
+
+	mock.Add(time.Second)
+	counter.Add(ctx, 10, attribute.String("A", "B"))
+	require.NoError(t, puller.ForceFlush(ctx))
+
+	// Cached, since the flush started a new collection period.
+	counter.Add(ctx, 10, attribute.String("A", "B"))
+	require.NoError(t, puller.Collect(ctx))
+
+	records := processortest.NewOutput(attribute.DefaultEncoder())
+	require.NoError(t, controllertest.ReadAll(puller, aggregation.CumulativeTemporalitySelector(), records.AddInstrumentationLibraryRecord))
+	require.EqualValues(t, map[string]float64{
+		"counter.sum/A=B/": 10,
+	}, records.Map())
+
+	mock.Add(time.Second)
+	require.NoError(t, puller.Collect(ctx))
+
+	records = processortest.NewOutput(attribute.DefaultEncoder())
+	require.NoError(t, controllertest.ReadAll(puller, aggregation.CumulativeTemporalitySelector(), records.AddInstrumentationLibraryRecord))
+	require.EqualValues(t, map[string]float64{
+		"counter.sum/A=B/": 20,
+	}, records.Map())
+}
@@ -150,6 +150,127 @@ func TestPushTicker(t *testing.T) {
 	require.NoError(t, p.Stop(ctx))
 }
 
+func TestPushForceFlush(t *testing.T) {
+	exporter := newExporter()
+	checkpointer := newCheckpointerFactory()
+	p := controller.New(
+		checkpointer,
+		controller.WithExporter(exporter),
+		controller.WithCollectPeriod(time.Second),
+		controller.WithResource(testResource),
+	)
+	meter := p.Meter("name")
+
+	mock := controllertest.NewMockClock()
+	p.SetClock(mock)
+
+	ctx := context.Background()
+
+	counter, err := meter.SyncInt64().Counter("counter.sum")
+	require.NoError(t, err)
+
+	require.NoError(t, p.Start(ctx))
+
+	counter.Add(ctx, 3)
+
+	require.NoError(t, p.ForceFlush(ctx))
+
+	require.EqualValues(t, map[string]float64{
+		"counter.sum//R=V": 3,
+	}, exporter.Values())
+
+	require.Equal(t, 1, exporter.ExportCount())
+	exporter.Reset()
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, p.ForceFlush(canceled), context.Canceled)
+	require.Equal(t, 0, exporter.ExportCount())
+
+	require.NoError(t, p.Stop(ctx))
+}
+
+// slowExporter delays each export before reading the checkpoint,
+// widening the window in which an unserialized collection could
+// replace it.
+type slowExporter struct {
+	*processortest.Exporter
+}
+
+func (e slowExporter) Export(ctx context.Context, res *resource.Resource, ckpt export.InstrumentationLibraryReader) error {
+	time.Sleep(time.Millisecond)
+	return e.Exporter.Export(ctx, res, ckpt)
+}
+
+// Test that ForceFlush racing with the ticker exports each delta
+// exactly once.
+func TestPushForceFlushConcurrentTicker(t *testing.T) {
+	exporter := newExporter()
+	p := controller.New(
+		processor.NewFactory(
+			processortest.AggregatorSelector(),
+			aggregation.DeltaTemporalitySelector(),
+		),
+		controller.WithExporter(slowExporter{exporter}),
+		controller.WithCollectPeriod(time.Second),
+		controller.WithResource(testResource),
+	)
+	meter := p.Meter("name")
+
+	mock := controllertest.NewMockClock()
+	p.SetClock(mock)
+
+	ctx := context.Background()
+
+	counter, err := meter.SyncInt64().Counter("counter.sum")
+	require.NoError(t, err)
+
+	require.NoError(t, p.Start(ctx))
+
+	const (
+		flushers = 4
+		rounds   = 50
+	)
+	done := make(chan struct{})
+	ticked := make(chan struct{})
+	go func() {
+		defer close(ticked)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				mock.Add(time.Second)
+			}
+		}
+	}()
+
+	// Distinct increments make a lost or repeated delta change the
+	// exported total.
+	var wg sync.WaitGroup
+	for f := 0; f < flushers; f++ {
+		wg.Add(1)
+		go func(f int) {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				counter.Add(ctx, int64(f*rounds+i))
+				if err := p.ForceFlush(ctx); err != nil {
+					t.Error(err)
+				}
+			}
+		}(f)
+	}
+	wg.Wait()
+	close(done)
+	<-ticked
+
+	require.NoError(t, p.Stop(ctx))
+
+	require.EqualValues(t, map[string]float64{
+		"counter.sum//R=V": (flushers * rounds) * (flushers*rounds - 1) / 2,
+	}, exporter.Values())
+}
+
 func TestPushExportError(t *testing.T) {
 	injector := func(name string, e error) func(r export.Record) error {
 		return func(r export.Record) error {
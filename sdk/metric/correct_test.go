@@ -161,6 +161,33 @@ func TestInputRangeHistogram(t *testing.T) {
 	require.Nil(t, testHandler.Flush())
 }
 
+func TestInputRangeNonFinite(t *testing.T) {
+	for _, name := range []string{"name.sum", "name.lastvalue", "name.histogram"} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			meter, sdk, _, processor := newSDK(t)
+
+			histogram, err := meter.SyncFloat64().Histogram(name)
+			require.NoError(t, err)
+
+			histogram.Record(ctx, 1)
+
+			histogram.Record(ctx, math.NaN())
+			require.Equal(t, aggregation.ErrNaNInput, testHandler.Flush())
+			histogram.Record(ctx, math.Inf(+1))
+			require.Equal(t, aggregation.ErrInfInput, testHandler.Flush())
+			histogram.Record(ctx, math.Inf(-1))
+			require.Equal(t, aggregation.ErrInfInput, testHandler.Flush())
+
+			checkpointed := sdk.Collect(ctx)
+			require.Equal(t, 1, checkpointed)
+			require.Equal(t, map[string]float64{
+				name + "//": 1,
+			}, processor.Values())
+		})
+	}
+}
+
 func TestDisabledInstrument(t *testing.T) {
 	ctx := context.Background()
 	meter, sdk, _, processor := newSDK(t)
@@ -175,6 +202,23 @@ func TestDisabledInstrument(t *testing.T) {
 	require.Equal(t, map[string]float64{}, processor.Values())
 }
 
+func TestUntouchedInstrument(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, _, processor := newSDK(t)
+
+	_, err := meter.SyncInt64().Counter("name.sum")
+	require.NoError(t, err)
+	_, err = meter.SyncFloat64().Histogram("name.histogram")
+	require.NoError(t, err)
+	_, err = meter.AsyncInt64().Gauge("name.lastvalue")
+	require.NoError(t, err)
+
+	checkpointed := sdk.Collect(ctx)
+
+	require.Equal(t, 0, checkpointed)
+	require.Equal(t, map[string]float64{}, processor.Values())
+}
+
 func TestRecordNaN(t *testing.T) {
 	ctx := context.Background()
 	meter, _, _, _ := newSDK(t)
@@ -388,6 +432,79 @@ func TestObserverCollection(t *testing.T) {
 	}
 }
 
+// TestObserverCoalesceAcrossCallbacks ensures that observations of the
+// same instrument and attribute set made by distinct callbacks in one
+// collection are summed for counter and up-down counter observers,
+// while the last value wins for gauges.
+func TestObserverCoalesceAcrossCallbacks(t *testing.T) {
+	type observer interface {
+		instrument.Asynchronous
+		Observe(ctx context.Context, x int64, attrs ...attribute.KeyValue)
+	}
+	for _, tt := range []struct {
+		name   string
+		newObs func(asyncint64.InstrumentProvider, string) (observer, error)
+		values []int64
+		// expect lists the acceptable collected values.
+		// Callback order is unspecified, so either value
+		// may win for gauges.
+		expect []float64
+	}{
+		{
+			name: "int.counterobserver.sum",
+			newObs: func(p asyncint64.InstrumentProvider, name string) (observer, error) {
+				return p.Counter(name)
+			},
+			values: []int64{3, 4},
+			expect: []float64{7},
+		},
+		{
+			name: "int.updowncounterobserver.sum",
+			newObs: func(p asyncint64.InstrumentProvider, name string) (observer, error) {
+				return p.UpDownCounter(name)
+			},
+			values: []int64{5, -2},
+			expect: []float64{3},
+		},
+		{
+			name: "int.gauge.lastvalue",
+			newObs: func(p asyncint64.InstrumentProvider, name string) (observer, error) {
+				return p.Gauge(name)
+			},
+			values: []int64{3, 4},
+			expect: []float64{3, 4},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			meter, sdk, _, processor := newSDK(t)
+
+			obs, err := tt.newObs(meter.AsyncInt64(), tt.name)
+			require.NoError(t, err)
+
+			for _, value := range tt.values {
+				value := value
+				err = meter.RegisterCallback([]instrument.Asynchronous{obs}, func(ctx context.Context) {
+					obs.Observe(ctx, value, attribute.String("A", "B"))
+				})
+				require.NoError(t, err)
+			}
+
+			for i := 0; i < 2; i++ {
+				processor.Reset()
+
+				collected := sdk.Collect(ctx)
+				require.Equal(t, 1, collected)
+
+				values := processor.Values()
+				require.Len(t, values, 1)
+				require.Contains(t, tt.expect, values[tt.name+"/A=B/"])
+			}
+			require.NoError(t, testHandler.Flush())
+		})
+	}
+}
+
 func TestCounterObserverInputRange(t *testing.T) {
 	ctx := context.Background()
 	meter, sdk, _, processor := newSDK(t)
@@ -569,4 +686,54 @@ func TestSyncInAsync(t *testing.T) {
 		"counter.sum//":        100,
 		"observer.lastvalue//": 10,
 	}, processor.Values())
+	require.ErrorIs(t, testHandler.Flush(), metricsdk.ErrSyncInCallback)
+
+	// Synchronous use outside of a callback is not reported.
+	counter.Add(ctx, 1)
+	require.NoError(t, testHandler.Flush())
+}
+
+// TestConcurrentRecordAndCollect records to a shared attribute set from
+// many goroutines while collections run concurrently.  Run with -race.
+func TestConcurrentRecordAndCollect(t *testing.T) {
+	const (
+		goroutines = 16
+		updates    = 1000
+	)
+	ctx := context.Background()
+	meter, sdk, _, processor := newSDK(t)
+
+	counter, err := meter.SyncInt64().Counter("name.sum")
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < updates; i++ {
+				counter.Add(ctx, 1, attribute.String("A", "B"))
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	for collecting := true; collecting; {
+		select {
+		case <-done:
+			collecting = false
+		default:
+			sdk.Collect(ctx)
+		}
+	}
+	sdk.Collect(ctx)
+
+	require.EqualValues(t, map[string]float64{
+		"name.sum/A=B/": goroutines * updates,
+	}, processor.Values())
+	require.Nil(t, testHandler.Flush())
 }
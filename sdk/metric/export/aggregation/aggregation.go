@@ -46,6 +46,25 @@ type (
 		Count() (uint64, error)
 	}
 
+	// Min returns the minimum value that was aggregated.
+	Min interface {
+		Aggregation
+		Min() (number.Number, error)
+	}
+
+	// Max returns the maximum value that was aggregated.
+	Max interface {
+		Aggregation
+		Max() (number.Number, error)
+	}
+
+	// ZeroCount returns the number of aggregated values that were
+	// exactly zero.
+	ZeroCount interface {
+		Aggregation
+		ZeroCount() (uint64, error)
+	}
+
 	// LastValue returns the latest value that was aggregated.
 	LastValue interface {
 		Aggregation
@@ -72,6 +91,33 @@ type (
 		Sum() (number.Number, error)
 		Histogram() (Buckets, error)
 	}
+
+	// ExponentialBuckets represents consecutive bucket counts of a
+	// base-2 exponential histogram.  The count at position i is
+	// for the bucket with index Offset()+i, which covers absolute
+	// values from base**index to base**(index+1), where
+	// base = 2**(2**-scale).
+	ExponentialBuckets interface {
+		// Offset returns the index of the first bucket.
+		Offset() int32
+		// Len returns the number of buckets.
+		Len() uint32
+		// At returns the count of the bucket at position pos.
+		At(pos uint32) uint64
+	}
+
+	// ExponentialHistogram returns the count of events in
+	// exponentially scaled buckets, for positive and negative
+	// values separately, with zeros counted apart.
+	ExponentialHistogram interface {
+		Aggregation
+		Count() (uint64, error)
+		Sum() (number.Number, error)
+		Scale() (int32, error)
+		ZeroCount() (uint64, error)
+		Positive() (ExponentialBuckets, error)
+		Negative() (ExponentialBuckets, error)
+	}
 )
 
 type (
@@ -95,12 +141,15 @@ const (
 	SumKind       Kind = "Sum"
 	HistogramKind Kind = "Histogram"
 	LastValueKind Kind = "Lastvalue"
+
+	ExponentialHistogramKind Kind = "ExponentialHistogram"
 )
 
 // Sentinel errors for Aggregation interface.
 var (
 	ErrNegativeInput    = fmt.Errorf("negative value is out of range for this instrument")
 	ErrNaNInput         = fmt.Errorf("invalid input value: NaN")
+	ErrInfInput         = fmt.Errorf("invalid input value: Inf")
 	ErrInconsistentType = fmt.Errorf("inconsistent aggregator types")
 
 	// ErrNoCumulativeToDelta is returned when requesting delta
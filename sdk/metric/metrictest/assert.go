@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictest // import "go.opentelemetry.io/otel/sdk/metric/metrictest"
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/number"
+)
+
+// TestingT is the subset of testing.TB used by AssertSeries.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// ExpectedSeries describes one series AssertSeries expects to find.
+type ExpectedSeries struct {
+	// Attributes must equal the series attributes exactly.
+	Attributes []attribute.KeyValue
+
+	// Value is compared with the Sum for Sum, Histogram and
+	// ExponentialHistogram aggregations and with the LastValue for
+	// LastValue aggregations.
+	Value float64
+
+	// Count is compared with the Count for Histogram and
+	// ExponentialHistogram aggregations.
+	Count uint64
+
+	// Delta is the absolute difference allowed between Value and
+	// a Float64 series value.  Float64 values always allow a
+	// relative difference of 1e-9.  Int64 values are compared
+	// exactly.
+	Delta float64
+}
+
+const floatTolerance = 1e-9
+
+// AssertSeries checks that records contain exactly the expected series
+// for the instrument called name, matching series by attributes.  On
+// a mismatch it reports every missing, extra and differing series in
+// one error and returns false.  Records that repeat the attributes of
+// an earlier record, for example from a second instrumentation
+// library using the same instrument name, are reported as duplicates.
+func AssertSeries(t TestingT, records []ExportRecord, name string, expected []ExpectedSeries) bool {
+	t.Helper()
+
+	var problems []string
+	actual := map[attribute.Distinct]ExportRecord{}
+	for _, rec := range records {
+		if rec.InstrumentName != name {
+			continue
+		}
+		set := attribute.NewSet(rec.Attributes...)
+		if _, ok := actual[set.Equivalent()]; ok {
+			problems = append(problems, fmt.Sprintf("duplicate: {%s} = %v from %q", encode(&set), recordValue(rec), rec.InstrumentationLibrary.InstrumentationName))
+			continue
+		}
+		actual[set.Equivalent()] = rec
+	}
+
+	for _, exp := range expected {
+		set := attribute.NewSet(exp.Attributes...)
+		rec, ok := actual[set.Equivalent()]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("missing: {%s} = %v", encode(&set), exp.Value))
+			continue
+		}
+		delete(actual, set.Equivalent())
+
+		if value := recordValue(rec); !valueMatches(rec.NumberKind, exp, value) {
+			problems = append(problems, fmt.Sprintf("value: {%s} expected %v, got %v", encode(&set), exp.Value, value))
+		}
+		if hasCount(rec) && rec.Count != exp.Count {
+			problems = append(problems, fmt.Sprintf("count: {%s} expected %d, got %d", encode(&set), exp.Count, rec.Count))
+		}
+	}
+	var extra []string
+	for _, rec := range actual {
+		set := attribute.NewSet(rec.Attributes...)
+		extra = append(extra, fmt.Sprintf("extra: {%s} = %v", encode(&set), recordValue(rec)))
+	}
+	sort.Strings(extra)
+	problems = append(problems, extra...)
+
+	if len(problems) == 0 {
+		return true
+	}
+	t.Errorf("series mismatch for %q:\n\t%s", name, strings.Join(problems, "\n\t"))
+	return false
+}
+
+func encode(set *attribute.Set) string {
+	return set.Encoded(attribute.DefaultEncoder())
+}
+
+// hasCount reports whether AssertSeries compares the Count of rec.
+func hasCount(rec ExportRecord) bool {
+	return rec.AggregationKind == aggregation.HistogramKind ||
+		rec.AggregationKind == aggregation.ExponentialHistogramKind
+}
+
+// recordValue returns the value AssertSeries compares for rec.
+func recordValue(rec ExportRecord) float64 {
+	if rec.AggregationKind == aggregation.LastValueKind {
+		return rec.LastValue.CoerceToFloat64(rec.NumberKind)
+	}
+	return rec.Sum.CoerceToFloat64(rec.NumberKind)
+}
+
+func valueMatches(kind number.Kind, exp ExpectedSeries, value float64) bool {
+	if kind == number.Int64Kind {
+		return value == exp.Value
+	}
+	allowed := math.Max(exp.Delta, floatTolerance*math.Abs(exp.Value))
+	return math.Abs(value-exp.Value) <= allowed
+}
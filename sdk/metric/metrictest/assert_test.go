@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictest_test // import "go.opentelemetry.io/otel/sdk/metric/metrictest"
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metrictest"
+)
+
+// recordingT records the errors reported by AssertSeries.
+type recordingT struct {
+	errors []string
+}
+
+func (*recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func collectSeries(t *testing.T) []metrictest.ExportRecord {
+	ctx := context.Background()
+	mp, exp := metrictest.NewTestMeterProvider()
+	meter := mp.Meter("go.opentelemetry.io/otel/sdk/metric/metrictest/assert_collectSeries")
+
+	icnt, err := meter.SyncInt64().Counter("iCount")
+	require.NoError(t, err)
+	icnt.Add(ctx, 2, attribute.String("A", "B"))
+	icnt.Add(ctx, 3, attribute.String("A", "C"))
+
+	fcnt, err := meter.SyncFloat64().Counter("fCount")
+	require.NoError(t, err)
+	fcnt.Add(ctx, 0.1, attribute.String("A", "B"))
+	fcnt.Add(ctx, 0.2, attribute.String("A", "B"))
+
+	fhis, err := meter.SyncFloat64().Histogram("fHist")
+	require.NoError(t, err)
+	fhis.Record(ctx, 1, attribute.String("A", "B"))
+	fhis.Record(ctx, 2, attribute.String("A", "B"))
+
+	require.NoError(t, exp.Collect(ctx))
+	return exp.GetRecords()
+}
+
+func TestAssertSeriesMatch(t *testing.T) {
+	records := collectSeries(t)
+
+	metrictest.AssertSeries(t, records, "iCount", []metrictest.ExpectedSeries{
+		{Attributes: []attribute.KeyValue{attribute.String("A", "C")}, Value: 3},
+		{Attributes: []attribute.KeyValue{attribute.String("A", "B")}, Value: 2},
+	})
+	metrictest.AssertSeries(t, records, "fHist", []metrictest.ExpectedSeries{
+		{Attributes: []attribute.KeyValue{attribute.String("A", "B")}, Value: 3, Count: 2},
+	})
+	metrictest.AssertSeries(t, records, "missing", nil)
+}
+
+func TestAssertSeriesFloatTolerance(t *testing.T) {
+	records := collectSeries(t)
+
+	// 0.1 + 0.2 is not exactly 0.3.
+	metrictest.AssertSeries(t, records, "fCount", []metrictest.ExpectedSeries{
+		{Attributes: []attribute.KeyValue{attribute.String("A", "B")}, Value: 0.3},
+	})
+
+	metrictest.AssertSeries(t, records, "fCount", []metrictest.ExpectedSeries{
+		{Attributes: []attribute.KeyValue{attribute.String("A", "B")}, Value: 0.31, Delta: 0.02},
+	})
+
+	rt := &recordingT{}
+	assert.False(t, metrictest.AssertSeries(rt, records, "fCount", []metrictest.ExpectedSeries{
+		{Attributes: []attribute.KeyValue{attribute.String("A", "B")}, Value: 0.31},
+	}))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], "value: {A=B} expected 0.31")
+}
+
+func TestAssertSeriesMismatch(t *testing.T) {
+	records := collectSeries(t)
+
+	rt := &recordingT{}
+	assert.False(t, metrictest.AssertSeries(rt, records, "iCount", []metrictest.ExpectedSeries{
+		{Attributes: []attribute.KeyValue{attribute.String("A", "B")}, Value: 2},
+		{Attributes: []attribute.KeyValue{attribute.String("A", "D")}, Value: 4},
+	}))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], `"iCount"`)
+	assert.Contains(t, rt.errors[0], "missing: {A=D} = 4")
+	assert.Contains(t, rt.errors[0], "extra: {A=C} = 3")
+	assert.NotContains(t, rt.errors[0], "A=B")
+
+	rt = &recordingT{}
+	assert.False(t, metrictest.AssertSeries(rt, records, "fHist", []metrictest.ExpectedSeries{
+		{Attributes: []attribute.KeyValue{attribute.String("A", "B")}, Value: 3, Count: 3},
+	}))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], "count: {A=B} expected 3, got 2")
+}
+
+func TestAssertSeriesDuplicate(t *testing.T) {
+	ctx := context.Background()
+	mp, exp := metrictest.NewTestMeterProvider()
+
+	for _, lib := range []string{"first", "second"} {
+		cnt, err := mp.Meter(lib).SyncInt64().Counter("iCount")
+		require.NoError(t, err)
+		cnt.Add(ctx, 2, attribute.String("A", "B"))
+	}
+	require.NoError(t, exp.Collect(ctx))
+
+	rt := &recordingT{}
+	assert.False(t, metrictest.AssertSeries(rt, exp.GetRecords(), "iCount", []metrictest.ExpectedSeries{
+		{Attributes: []attribute.KeyValue{attribute.String("A", "B")}, Value: 2},
+	}))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], "duplicate: {A=B} = 2 from")
+}
@@ -14,15 +14,21 @@
 
 package metrictest // import "go.opentelemetry.io/otel/sdk/metric/metrictest"
 
-import "go.opentelemetry.io/otel/sdk/metric/export/aggregation"
+import (
+	"go.opentelemetry.io/otel/sdk/metric/export"
+	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
+	selector "go.opentelemetry.io/otel/sdk/metric/selector/simple"
+)
 
 type config struct {
 	temporalitySelector aggregation.TemporalitySelector
+	aggregatorSelector  export.AggregatorSelector
 }
 
 func newConfig(opts ...Option) config {
 	cfg := config{
 		temporalitySelector: aggregation.CumulativeTemporalitySelector(),
+		aggregatorSelector:  selector.NewWithHistogramDistribution(),
 	}
 	for _, opt := range opts {
 		cfg = opt.apply(cfg)
@@ -55,3 +61,16 @@ func WithTemporalitySelector(ts aggregation.TemporalitySelector) Option {
 		return cfg
 	})
 }
+
+// WithAggregatorSelector sets the aggregators used for each instrument.
+// The default uses explicit-boundary histograms for Histogram
+// instruments.
+func WithAggregatorSelector(as export.AggregatorSelector) Option {
+	return functionOption(func(cfg config) config {
+		if as == nil {
+			return cfg
+		}
+		cfg.aggregatorSelector = as
+		return cfg
+	})
+}
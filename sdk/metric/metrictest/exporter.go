@@ -28,7 +28,6 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/number"
 	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
 	"go.opentelemetry.io/otel/sdk/metric/sdkapi"
-	selector "go.opentelemetry.io/otel/sdk/metric/selector/simple"
 )
 
 // Exporter is a manually collected exporter for testing the SDK.  It does not
@@ -51,7 +50,7 @@ func NewTestMeterProvider(opts ...Option) (metric.MeterProvider, *Exporter) {
 
 	c := controller.New(
 		processor.NewFactory(
-			selector.NewWithHistogramDistribution(),
+			cfg.aggregatorSelector,
 			cfg.temporalitySelector,
 		),
 		controller.WithCollectPeriod(0),
@@ -127,6 +126,18 @@ func (e *Exporter) Collect(ctx context.Context) error {
 				if err != nil {
 					return err
 				}
+			case aggregation.ExponentialHistogram:
+				// Checked before Count and Sum, which it also
+				// implements.
+				record.AggregationKind = aggregation.ExponentialHistogramKind
+				record.Sum, err = agg.Sum()
+				if err != nil {
+					return err
+				}
+				record.Count, err = agg.Count()
+				if err != nil {
+					return err
+				}
 			case aggregation.Count:
 				record.Count, err = agg.Count()
 				if err != nil {
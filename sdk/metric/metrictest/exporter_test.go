@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metrictest"
+	selector "go.opentelemetry.io/otel/sdk/metric/selector/simple"
 )
 
 func TestSyncInstruments(t *testing.T) {
@@ -126,6 +127,40 @@ func TestSyncInstruments(t *testing.T) {
 	})
 }
 
+func TestExponentialHistogram(t *testing.T) {
+	ctx := context.Background()
+	mp, exp := metrictest.NewTestMeterProvider(
+		metrictest.WithAggregatorSelector(selector.NewWithExponentialHistogramDistribution()),
+	)
+	meter := mp.Meter("go.opentelemetry.io/otel/sdk/metric/metrictest/exporter_TestExponentialHistogram")
+
+	fhis, err := meter.SyncFloat64().Histogram("fHist")
+	require.NoError(t, err)
+
+	fhis.Record(ctx, 1.5)
+	fhis.Record(ctx, 3)
+
+	err = exp.Collect(context.Background())
+	require.NoError(t, err)
+
+	out, err := exp.GetByName("fHist")
+	require.NoError(t, err)
+	assert.InDelta(t, 4.5, out.Sum.AsFloat64(), 0.0001)
+	assert.EqualValues(t, 2, out.Count)
+	assert.Equal(t, aggregation.ExponentialHistogramKind, out.AggregationKind)
+
+	metrictest.AssertSeries(t, exp.GetRecords(), "fHist", []metrictest.ExpectedSeries{
+		{Value: 4.5, Count: 2},
+	})
+
+	rt := &recordingT{}
+	assert.False(t, metrictest.AssertSeries(rt, exp.GetRecords(), "fHist", []metrictest.ExpectedSeries{
+		{Value: 4.5, Count: 3},
+	}))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], "count: {} expected 3, got 2")
+}
+
 func TestSyncDeltaInstruments(t *testing.T) {
 	ctx := context.Background()
 	mp, exp := metrictest.NewTestMeterProvider(metrictest.WithTemporalitySelector(aggregation.DeltaTemporalitySelector()))
@@ -16,6 +16,7 @@ package number
 
 import (
 	"math"
+	"sync"
 	"testing"
 	"unsafe"
 
@@ -210,3 +211,83 @@ func TestNumberSignChange(t *testing.T) {
 		require.Equal(t, negFloat, NewNumberSignChange(Float64Kind, posFloat))
 	})
 }
+
+func TestNumberRoundTrip(t *testing.T) {
+	t.Run("Int64", func(t *testing.T) {
+		for _, i := range []int64{0, -1, math.MinInt64, math.MaxInt64} {
+			n := NewInt64Number(i)
+			require.Equal(t, i, n.AsInt64())
+			require.Equal(t, float64(i), n.CoerceToFloat64(Int64Kind))
+		}
+	})
+
+	t.Run("Float64", func(t *testing.T) {
+		for _, f := range []float64{0, -1.5, math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64, math.Inf(+1), math.Inf(-1)} {
+			n := NewFloat64Number(f)
+			require.Equal(t, f, n.AsFloat64())
+		}
+	})
+
+	t.Run("Float64NegativeZero", func(t *testing.T) {
+		n := NewFloat64Number(math.Copysign(0, -1))
+		require.True(t, math.Signbit(n.AsFloat64()))
+		require.NotEqual(t, NewFloat64Number(0), n)
+	})
+
+	t.Run("Float64NaN", func(t *testing.T) {
+		n := NewFloat64Number(math.NaN())
+		require.True(t, math.IsNaN(n.AsFloat64()))
+	})
+
+	t.Run("Coerce", func(t *testing.T) {
+		f := NewFloat64Number(-2.75)
+		require.Equal(t, int64(-2), f.CoerceToInt64(Float64Kind))
+
+		i := NewInt64Number(1 << 53)
+		require.Equal(t, float64(1<<53), i.CoerceToFloat64(Int64Kind))
+	})
+}
+
+func TestNumberAtomicConcurrent(t *testing.T) {
+	const (
+		goroutines = 16
+		adds       = 1000
+	)
+
+	run := func(f func()) {
+		var wg sync.WaitGroup
+		for g := 0; g < goroutines; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < adds; i++ {
+					f()
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	t.Run("Int64", func(t *testing.T) {
+		var n Number
+		run(func() { n.AddNumberAtomic(Int64Kind, NewInt64Number(2)) })
+		require.Equal(t, int64(2*goroutines*adds), n.AsInt64Atomic())
+	})
+
+	t.Run("Float64", func(t *testing.T) {
+		var n Number
+		run(func() { n.AddNumberAtomic(Float64Kind, NewFloat64Number(0.5)) })
+		require.Equal(t, 0.5*goroutines*adds, n.AsFloat64Atomic())
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		var n, total Number
+		run(func() {
+			n.AddInt64Atomic(1)
+			old := n.SwapNumberAtomic(0)
+			total.AddNumberAtomic(Int64Kind, old)
+		})
+		total.AddInt64Atomic(n.AsInt64Atomic())
+		require.Equal(t, int64(goroutines*adds), total.AsInt64Atomic())
+	})
+}
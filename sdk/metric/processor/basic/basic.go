@@ -82,6 +82,10 @@ type (
 		// by the processor used to store the last cumulative
 		// value.
 		cumulative aggregator.Aggregator
+
+		// start is the start time of the cumulative value, used
+		// when stateful is true.
+		start time.Time
 	}
 
 	state struct {
@@ -184,6 +188,14 @@ func (b *Processor) Process(accum export.Accumulation) error {
 			current:  agg,
 		}
 		if stateful {
+			newValue.start = b.state.processStart
+			if b.config.InactivityLimit > 0 {
+				// This attribute set may have been forgotten
+				// before, in which case its cumulative value
+				// restarts from zero.  Start it with the current
+				// interval so that exporters observe the reset.
+				newValue.start = b.state.intervalStart
+			}
 			if desc.InstrumentKind().PrecomputedSum() {
 				// To convert precomputed sums to
 				// deltas requires two aggregators to
@@ -294,6 +306,14 @@ func (b *Processor) FinishCollection() error {
 		stale := value.updated != b.finishedCollection
 		stateless := !value.stateful
 
+		// Forget entries that have not been updated for the
+		// configured number of collections, whether or not
+		// they are stateful.
+		if limit := int64(b.config.InactivityLimit); limit > 0 && b.finishedCollection-value.updated >= limit {
+			delete(b.values, key)
+			continue
+		}
+
 		// The following branch updates stateful aggregators.  Skip
 		// these updates if the aggregator is not stateful or if the
 		// aggregator is stale.
@@ -344,10 +364,11 @@ func (b *state) ForEach(exporter aggregation.TemporalitySelector, f func(export.
 			// value:
 			if value.stateful {
 				agg = value.cumulative.Aggregation()
+				start = value.start
 			} else {
 				agg = value.current.Aggregation()
+				start = b.processStart
 			}
-			start = b.processStart
 
 		case aggregation.DeltaTemporality:
 			// Precomputed sums are a special case.
@@ -398,6 +398,121 @@ func TestStatefulNoMemoryCumulative(t *testing.T) {
 	}
 }
 
+func TestInactivityLimitCumulative(t *testing.T) {
+	aggTempSel := aggregation.CumulativeTemporalitySelector()
+
+	desc := metrictest.NewDescriptor("inst.sum", sdkapi.CounterInstrumentKind, number.Int64Kind)
+	selector := processortest.AggregatorSelector()
+
+	processor := basic.New(selector, aggTempSel, basic.WithMemory(true), basic.WithInactivityLimit(2))
+	reader := processor.Reader()
+
+	starts := map[string]time.Time{}
+	collect := func(attrs ...attribute.KeyValue) map[string]float64 {
+		processor.StartCollection()
+		for _, attr := range attrs {
+			require.NoError(t, processor.Process(updateFor(t, &desc, selector, 10, attr)))
+		}
+		require.NoError(t, processor.FinishCollection())
+
+		records := processortest.NewOutput(attribute.DefaultEncoder())
+		require.NoError(t, reader.ForEach(aggTempSel, func(rec export.Record) error {
+			starts[rec.Attributes().Encoded(attribute.DefaultEncoder())] = rec.StartTime()
+			return records.AddRecord(rec)
+		}))
+		// Ensure the next collection interval starts later.
+		time.Sleep(time.Millisecond)
+		return records.Map()
+	}
+
+	active := attribute.String("A", "B")
+	idle := attribute.String("C", "D")
+
+	require.EqualValues(t, map[string]float64{
+		"inst.sum/A=B/": 10,
+		"inst.sum/C=D/": 10,
+	}, collect(active, idle))
+
+	// One idle collection is within the limit.
+	require.EqualValues(t, map[string]float64{
+		"inst.sum/A=B/": 20,
+		"inst.sum/C=D/": 10,
+	}, collect(active))
+
+	// Two idle collections reach the limit.
+	require.EqualValues(t, map[string]float64{
+		"inst.sum/A=B/": 30,
+	}, collect(active))
+
+	activeStart, idleStart := starts["A=B"], starts["C=D"]
+
+	// A forgotten attribute set starts over from zero, with a later
+	// start time.
+	require.EqualValues(t, map[string]float64{
+		"inst.sum/A=B/": 40,
+		"inst.sum/C=D/": 10,
+	}, collect(active, idle))
+	require.Equal(t, activeStart, starts["A=B"])
+	require.True(t, starts["C=D"].After(idleStart))
+}
+
+func TestInactivityLimitChurn(t *testing.T) {
+	aggTempSel := aggregation.CumulativeTemporalitySelector()
+
+	desc := metrictest.NewDescriptor("inst.sum", sdkapi.CounterInstrumentKind, number.Int64Kind)
+	selector := processortest.AggregatorSelector()
+
+	processor := basic.New(selector, aggTempSel, basic.WithMemory(true), basic.WithInactivityLimit(2))
+	reader := processor.Reader()
+
+	for i := 0; i < 100; i++ {
+		processor.StartCollection()
+		require.NoError(t, processor.Process(updateFor(t, &desc, selector, 10, attribute.Int("I", i))))
+		require.NoError(t, processor.FinishCollection())
+
+		records := processortest.NewOutput(attribute.DefaultEncoder())
+		require.NoError(t, reader.ForEach(aggTempSel, records.AddRecord))
+		require.LessOrEqual(t, len(records.Map()), 2)
+		require.Equal(t, 10., records.Map()[fmt.Sprintf("inst.sum/I=%d/", i)])
+	}
+}
+
+// kindTemporalitySelector selects Delta for counters and Cumulative
+// for all other instrument kinds.
+type kindTemporalitySelector struct{}
+
+func (kindTemporalitySelector) TemporalityFor(desc *sdkapi.Descriptor, _ aggregation.Kind) aggregation.Temporality {
+	if desc.InstrumentKind() == sdkapi.CounterInstrumentKind {
+		return aggregation.DeltaTemporality
+	}
+	return aggregation.CumulativeTemporality
+}
+
+func TestTemporalityByInstrumentKind(t *testing.T) {
+	aggTempSel := kindTemporalitySelector{}
+
+	counter := metrictest.NewDescriptor("counter.sum", sdkapi.CounterInstrumentKind, number.Int64Kind)
+	updown := metrictest.NewDescriptor("updowncounter.sum", sdkapi.UpDownCounterInstrumentKind, number.Int64Kind)
+	selector := processortest.AggregatorSelector()
+
+	processor := basic.New(selector, aggTempSel)
+	reader := processor.Reader()
+
+	for i := 1; i < 4; i++ {
+		processor.StartCollection()
+		require.NoError(t, processor.Process(updateFor(t, &counter, selector, 10)))
+		require.NoError(t, processor.Process(updateFor(t, &updown, selector, 10)))
+		require.NoError(t, processor.FinishCollection())
+
+		records := processortest.NewOutput(attribute.DefaultEncoder())
+		require.NoError(t, reader.ForEach(aggTempSel, records.AddRecord))
+		require.EqualValues(t, map[string]float64{
+			"counter.sum//":       10,
+			"updowncounter.sum//": float64(10 * i),
+		}, records.Map())
+	}
+}
+
 func TestMultiObserverSum(t *testing.T) {
 	for _, test := range []struct {
 		name string
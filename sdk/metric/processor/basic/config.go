@@ -21,6 +21,13 @@ type config struct {
 	// Reader.ForEach() will visit metrics that were not updated in the most
 	// recent interval.
 	Memory bool
+
+	// InactivityLimit is the number of consecutive collections
+	// without updates after which the processor forgets a metric
+	// instrument and attribute set, including any cumulative state.
+	// When zero or negative, entries are never forgotten because of
+	// inactivity.
+	InactivityLimit int
 }
 
 // Option configures a basic processor configuration.
@@ -41,3 +48,25 @@ func (m memoryOption) applyProcessor(cfg config) config {
 	cfg.Memory = bool(m)
 	return cfg
 }
+
+// WithInactivityLimit sets the number of consecutive collections
+// without updates after which a Processor forgets a metric instrument
+// and attribute set.  This bounds the memory used for cumulative
+// aggregation when attribute sets stop being reported.  A forgotten
+// attribute set that is updated again starts from a zero value, with
+// the start time of the collection interval in which it reappears.
+//
+// By default, or when n is zero or negative, entries are not forgotten
+// because of inactivity.  Setting a limit also makes new cumulative
+// series start with the collection interval in which they first appear,
+// rather than the process start time.
+func WithInactivityLimit(n int) Option {
+	return inactivityLimitOption(n)
+}
+
+type inactivityLimitOption int
+
+func (o inactivityLimitOption) applyProcessor(cfg config) config {
+	cfg.InactivityLimit = int(o)
+	return cfg
+}
@@ -16,6 +16,7 @@ package reducer_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -115,3 +116,87 @@ func TestFilterBasicProcessor(t *testing.T) {
 		"observer.sum/A=1,C=3/R=V": 20,
 	}, exporter.Values())
 }
+
+// Test that attribute sets which collapse to the same filtered set
+// merge into one aggregation rather than overwrite each other.
+func TestFilterCollisionsMerge(t *testing.T) {
+	ctx := context.Background()
+	basicProc := basic.New(processortest.AggregatorSelector(), aggregation.CumulativeTemporalitySelector(), basic.WithMemory(true))
+	accum := metricsdk.NewAccumulator(
+		reducer.New(testFilter{}, basicProc),
+	)
+	exporter := processortest.New(basicProc, attribute.DefaultEncoder())
+	meter := sdkapi.WrapMeterImpl(accum)
+
+	counter, err := meter.SyncInt64().Counter("counter.sum")
+	require.NoError(t, err)
+	histogram, err := meter.SyncFloat64().Histogram("histogram.histogram")
+	require.NoError(t, err)
+
+	collect := func() map[string]float64 {
+		basicProc.StartCollection()
+		accum.Collect(ctx)
+		require.NoError(t, basicProc.FinishCollection())
+
+		exporter.Reset()
+		require.NoError(t, exporter.Export(ctx, resource.Empty(), processortest.OneInstrumentationLibraryReader(instrumentation.Library{
+			Name: "test",
+		}, basicProc.Reader())))
+		return exporter.Values()
+	}
+
+	for b := 0; b < 10; b++ {
+		counter.Add(ctx, 1, attribute.Int("A", 1), attribute.Int("B", b))
+		histogram.Record(ctx, float64(b), attribute.Int("A", 1), attribute.Int("B", b))
+	}
+
+	require.EqualValues(t, map[string]float64{
+		"counter.sum/A=1/":         10,
+		"histogram.histogram/A=1/": 45,
+	}, collect())
+
+	for b := 0; b < 10; b++ {
+		counter.Add(ctx, 1, attribute.Int("A", 1), attribute.Int("B", b))
+	}
+
+	require.EqualValues(t, map[string]float64{
+		"counter.sum/A=1/":         20,
+		"histogram.histogram/A=1/": 45,
+	}, collect())
+}
+
+func BenchmarkFilterHighCollision(b *testing.B) {
+	ctx := context.Background()
+	accum := metricsdk.NewAccumulator(
+		reducer.New(testFilter{}, processortest.NewCheckpointer(processortest.NewProcessor(
+			processortest.AggregatorSelector(),
+			attribute.DefaultEncoder(),
+		))),
+	)
+	meter := sdkapi.WrapMeterImpl(accum)
+
+	counter, err := meter.SyncInt64().Counter("counter.sum")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	const distinct = 100
+	attrs := make([][]attribute.KeyValue, distinct)
+	for i := range attrs {
+		attrs[i] = []attribute.KeyValue{
+			attribute.Int("A", 1),
+			attribute.String("B", fmt.Sprint(i)),
+			attribute.Int("C", 3),
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		counter.Add(ctx, 1, attrs[i%distinct]...)
+		if i%distinct == distinct-1 {
+			accum.Collect(ctx)
+		}
+	}
+}
@@ -36,7 +36,7 @@ var _ sdkapi.MeterImpl = (*UniqueInstrumentMeterImpl)(nil)
 // ErrMetricKindMismatch is the standard error for mismatched metric
 // instrument definitions.
 var ErrMetricKindMismatch = fmt.Errorf(
-	"a metric was already registered by this name with another kind or number type")
+	"a metric was already registered by this name with another kind, number type or unit")
 
 // NewUniqueInstrumentMeterImpl returns a wrapped metric.MeterImpl
 // with the addition of instrument name uniqueness checking.
@@ -63,14 +63,48 @@ func NewMetricKindMismatchError(desc sdkapi.Descriptor) error {
 		ErrMetricKindMismatch)
 }
 
+// ConflictError is returned when an instrument is registered with a
+// name already in use by an incompatible instrument.  It wraps
+// ErrMetricKindMismatch.
+type ConflictError struct {
+	// Existing describes the instrument that was registered first.
+	Existing sdkapi.Descriptor
+
+	// Candidate describes the conflicting registration.
+	Candidate sdkapi.Descriptor
+}
+
+var _ error = (*ConflictError)(nil)
+
+// Error implements error.  When the units differ the message names
+// both of them, since the kind and number type alone may not show
+// the conflict.
+func (e *ConflictError) Error() string {
+	msg := NewMetricKindMismatchError(e.Existing).Error()
+	if e.Existing.Unit() != e.Candidate.Unit() {
+		msg += fmt.Sprintf(" (registered with unit %q, requested with unit %q)",
+			e.Existing.Unit(),
+			e.Candidate.Unit())
+	}
+	return msg
+}
+
+// Unwrap returns ErrMetricKindMismatch.
+func (e *ConflictError) Unwrap() error {
+	return ErrMetricKindMismatch
+}
+
 // Compatible determines whether two sdkapi.Descriptors are considered
-// the same for the purpose of uniqueness checking.
+// the same for the purpose of uniqueness checking.  Descriptors that
+// differ only by description are compatible, in which case the first
+// registration is used.
 func Compatible(candidate, existing sdkapi.Descriptor) bool {
 	return candidate.InstrumentKind() == existing.InstrumentKind() &&
-		candidate.NumberKind() == existing.NumberKind()
+		candidate.NumberKind() == existing.NumberKind() &&
+		candidate.Unit() == existing.Unit()
 }
 
-// checkUniqueness returns an ErrMetricKindMismatch error if there is
+// checkUniqueness returns a *ConflictError if there is
 // a conflict between a descriptor that was already registered and the
 // `descriptor` argument.  If there is an existing compatible
 // registration, this returns the already-registered instrument.  If
@@ -82,7 +116,10 @@ func (u *UniqueInstrumentMeterImpl) checkUniqueness(descriptor sdkapi.Descriptor
 	}
 
 	if !Compatible(descriptor, impl.Descriptor()) {
-		return nil, NewMetricKindMismatchError(impl.Descriptor())
+		return nil, &ConflictError{
+			Existing:  impl.Descriptor(),
+			Candidate: descriptor,
+		}
 	}
 
 	return impl, nil
@@ -21,7 +21,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/unit"
 	metricsdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/number"
 	"go.opentelemetry.io/otel/sdk/metric/registry"
 	"go.opentelemetry.io/otel/sdk/metric/sdkapi"
 )
@@ -110,3 +112,51 @@ func TestRegistryDiffInstruments(t *testing.T) {
 		}
 	}
 }
+
+func TestRegistryDiffDescription(t *testing.T) {
+	impl := registry.NewUniqueInstrumentMeterImpl(metricsdk.NewAccumulator(nil))
+
+	inst1, err1 := impl.NewSyncInstrument(sdkapi.NewDescriptor("this", sdkapi.CounterInstrumentKind, number.Int64Kind, "first", unit.Bytes))
+	inst2, err2 := impl.NewSyncInstrument(sdkapi.NewDescriptor("this", sdkapi.CounterInstrumentKind, number.Int64Kind, "second", unit.Bytes))
+
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	require.Equal(t, inst1, inst2)
+	require.Equal(t, "first", inst2.Descriptor().Description())
+}
+
+func TestRegistryDiffUnit(t *testing.T) {
+	impl := registry.NewUniqueInstrumentMeterImpl(metricsdk.NewAccumulator(nil))
+
+	_, err := impl.NewSyncInstrument(sdkapi.NewDescriptor("this", sdkapi.CounterInstrumentKind, number.Int64Kind, "", unit.Bytes))
+	require.NoError(t, err)
+
+	other, err := impl.NewSyncInstrument(sdkapi.NewDescriptor("this", sdkapi.CounterInstrumentKind, number.Int64Kind, "", unit.Milliseconds))
+	require.Nil(t, other)
+	require.ErrorIs(t, err, registry.ErrMetricKindMismatch)
+	require.Contains(t, err.Error(), `registered with unit "By", requested with unit "ms"`)
+
+	// Units are named whenever they differ, also alongside a kind conflict.
+	_, err = impl.NewSyncInstrument(sdkapi.NewDescriptor("this", sdkapi.HistogramInstrumentKind, number.Int64Kind, "", unit.Milliseconds))
+	require.ErrorIs(t, err, registry.ErrMetricKindMismatch)
+	require.Contains(t, err.Error(), `registered with unit "By", requested with unit "ms"`)
+}
+
+func TestRegistryConflictError(t *testing.T) {
+	impl := registry.NewUniqueInstrumentMeterImpl(metricsdk.NewAccumulator(nil))
+
+	existing := sdkapi.NewDescriptor("this", sdkapi.CounterInstrumentKind, number.Int64Kind, "", unit.Bytes)
+	candidate := sdkapi.NewDescriptor("this", sdkapi.HistogramInstrumentKind, number.Float64Kind, "", unit.Bytes)
+
+	_, err := impl.NewSyncInstrument(existing)
+	require.NoError(t, err)
+
+	_, err = impl.NewSyncInstrument(candidate)
+	require.ErrorIs(t, err, registry.ErrMetricKindMismatch)
+
+	var conflict *registry.ConflictError
+	require.True(t, errors.As(err, &conflict))
+	require.Equal(t, existing, conflict.Existing)
+	require.Equal(t, candidate, conflict.Candidate)
+	require.Equal(t, registry.NewMetricKindMismatchError(existing).Error(), err.Error())
+}
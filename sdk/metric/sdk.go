@@ -131,6 +131,11 @@ var (
 	// ErrBadInstrument is returned when an instrument from another SDK is
 	// attempted to be registered with this SDK.
 	ErrBadInstrument = fmt.Errorf("use of a instrument from another SDK")
+
+	// ErrSyncInCallback is reported when a synchronous instrument is
+	// used from within an asynchronous callback of the same
+	// Accumulator.  The measurement is still recorded.
+	ErrSyncInCallback = fmt.Errorf("synchronous instrument used within an asynchronous callback")
 )
 
 func (b *baseInstrument) Descriptor() sdkapi.Descriptor {
@@ -211,6 +216,12 @@ func (b *baseInstrument) acquireHandle(kvs []attribute.KeyValue) *record {
 //
 // The order of the input array `kvs` may be sorted after the function is called.
 func (s *syncInstrument) RecordOne(ctx context.Context, num number.Number, kvs []attribute.KeyValue) {
+	if ctx.Value(asyncContextKey{}) == s.meter {
+		// This usually indicates a coding mistake, since
+		// callbacks are meant to observe asynchronous
+		// instruments.
+		otel.Handle(fmt.Errorf("%s: %w", s.descriptor.Name(), ErrSyncInCallback))
+	}
 	h := s.acquireHandle(kvs)
 	defer h.unbind()
 	h.captureOne(ctx, num)
@@ -265,6 +276,14 @@ func (m *Accumulator) NewAsyncInstrument(descriptor sdkapi.Descriptor) (sdkapi.A
 }
 
 // RegisterCallback registers f to be called for insts.
+//
+// An instrument may be observed by more than one callback.  When
+// several callbacks observe the same instrument and attributes in one
+// collection, the observations are combined by the instrument's
+// aggregator, as with repeated synchronous measurements: Sum
+// aggregators add them, so CounterObserver and UpDownCounterObserver
+// report the total of the callbacks' values, while LastValue
+// aggregators keep one of them, in unspecified order.
 func (m *Accumulator) RegisterCallback(insts []instrument.Asynchronous, f func(context.Context)) error {
 	cb := &callback{
 		insts: map[*asyncInstrument]struct{}{},
@@ -293,6 +312,10 @@ func (m *Accumulator) RegisterCallback(insts []instrument.Asynchronous, f func(c
 // exports data for each active instrument.  Collect() may not be
 // called concurrently.
 //
+// Asynchronous callbacks are not run once the context is done, so a
+// callback that outlives the context's deadline prevents the remaining
+// callbacks from observing in this collection.
+//
 // During the collection pass, the export.Processor will receive
 // one Export() call per current aggregation.
 //
@@ -358,6 +381,12 @@ func (m *Accumulator) runAsyncCallbacks(ctx context.Context) {
 	ctx = context.WithValue(ctx, asyncContextKey{}, m)
 
 	for cb := range m.callbacks {
+		if ctx.Err() != nil {
+			// The collection was canceled or timed out.  Skip the
+			// remaining callbacks; observations made so far are
+			// still collected.
+			return
+		}
 		cb.f(ctx)
 	}
 }
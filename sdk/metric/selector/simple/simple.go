@@ -16,6 +16,7 @@ package simple // import "go.opentelemetry.io/otel/sdk/metric/selector/simple"
 
 import (
 	"go.opentelemetry.io/otel/sdk/metric/aggregator"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/lastvalue"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
@@ -28,11 +29,15 @@ type (
 	selectorHistogram   struct {
 		options []histogram.Option
 	}
+	selectorExponential struct {
+		options []exponential.Option
+	}
 )
 
 var (
 	_ export.AggregatorSelector = selectorInexpensive{}
 	_ export.AggregatorSelector = selectorHistogram{}
+	_ export.AggregatorSelector = selectorExponential{}
 )
 
 // NewWithInexpensiveDistribution returns a simple aggregator selector
@@ -51,6 +56,14 @@ func NewWithHistogramDistribution(options ...histogram.Option) export.Aggregator
 	return selectorHistogram{options: options}
 }
 
+// NewWithExponentialHistogramDistribution returns a simple aggregator
+// selector that uses base-2 exponential histogram aggregators for
+// `Histogram` instruments.  These adjust their resolution to the
+// range of recorded values, so they need no configured boundaries.
+func NewWithExponentialHistogramDistribution(options ...exponential.Option) export.AggregatorSelector {
+	return selectorExponential{options: options}
+}
+
 func sumAggs(aggPtrs []*aggregator.Aggregator) {
 	aggs := sum.New(len(aggPtrs))
 	for i := range aggPtrs {
@@ -92,3 +105,17 @@ func (s selectorHistogram) AggregatorFor(descriptor *sdkapi.Descriptor, aggPtrs
 		sumAggs(aggPtrs)
 	}
 }
+
+func (s selectorExponential) AggregatorFor(descriptor *sdkapi.Descriptor, aggPtrs ...*aggregator.Aggregator) {
+	switch descriptor.InstrumentKind() {
+	case sdkapi.GaugeObserverInstrumentKind:
+		lastValueAggs(aggPtrs)
+	case sdkapi.HistogramInstrumentKind:
+		aggs := exponential.New(len(aggPtrs), descriptor, s.options...)
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	default:
+		sumAggs(aggPtrs)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/sdk/metric/aggregator"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/lastvalue"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
@@ -64,3 +65,9 @@ func TestHistogramDistribution(t *testing.T) {
 	require.IsType(t, (*histogram.Aggregator)(nil), oneAgg(hist, &testHistogramDesc))
 	testFixedSelectors(t, hist)
 }
+
+func TestExponentialHistogramDistribution(t *testing.T) {
+	hist := simple.NewWithExponentialHistogramDistribution()
+	require.IsType(t, (*exponential.Aggregator)(nil), oneAgg(hist, &testHistogramDesc))
+	testFixedSelectors(t, hist)
+}